@@ -126,6 +126,7 @@ enum class PrimitiveType(
     BooleanType("boolean", "bool", "bool", "Boolean", alternativeCNames = setOf("_Bool")),
     Int64Type("int64", "int64", "int64_t", "Long", jvmNameInStruct = "int64_t", cgoConversionFunctionName = "C.int64_t"),
     Int32Type("int32", "int32", "int32_t", "Int", jvmNameInStruct = "int32_t", cgoConversionFunctionName = "C.int32_t"),
+    Float64Type("float64", "float64", "double", "Double", jvmNameInStruct = "Double", cgoConversionFunctionName = "C.double"),
     ByteType("byteArray", "[]byte", "void*", "Pointer", isPointer = true, cgoConversionFunctionName = "C.CBytes"),
     GenericPointerType("void*", "unsafe.Pointer", "void*", "Pointer?"),
     ;
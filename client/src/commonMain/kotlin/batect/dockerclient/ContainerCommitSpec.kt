@@ -0,0 +1,91 @@
+/*
+    Copyright 2017-2022 Charles Korn.
+
+    Licensed under the Apache License, Version 2.0 (the "License");
+    you may not use this file except in compliance with the License.
+    You may obtain a copy of the License at
+
+        https://www.apache.org/licenses/LICENSE-2.0
+
+    Unless required by applicable law or agreed to in writing, software
+    distributed under the License is distributed on an "AS IS" BASIS,
+    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+    See the License for the specific language governing permissions and
+    limitations under the License.
+*/
+
+package batect.dockerclient
+
+/**
+ * A specification for creating a new image from the current state of a container.
+ *
+ * @see [DockerClient.commitContainer]
+ */
+public data class ContainerCommitSpec(
+    val container: ContainerReference,
+    val reference: String? = null,
+    val author: String? = null,
+    val message: String? = null,
+    val changes: List<String> = emptyList(),
+    val labels: Map<String, String> = emptyMap(),
+    val pause: Boolean = true,
+) {
+    /**
+     * Builder to create an instance of a [ContainerCommitSpec] for use with [DockerClient.commitContainer].
+     *
+     * @see [DockerClient.commitContainer]
+     */
+    public class Builder(container: ContainerReference) {
+        private var spec = ContainerCommitSpec(container)
+
+        /**
+         * Sets the repository and tag to apply to the new image, for example `my-image:latest`.
+         */
+        public fun withReference(reference: String): Builder {
+            spec = spec.copy(reference = reference)
+
+            return this
+        }
+
+        public fun withAuthor(author: String): Builder {
+            spec = spec.copy(author = author)
+
+            return this
+        }
+
+        public fun withMessage(message: String): Builder {
+            spec = spec.copy(message = message)
+
+            return this
+        }
+
+        /**
+         * Applies a Dockerfile instruction to the new image, like the Docker CLI's `--change` option (for example, `ENV KEY=value` or `CMD ["sh"]`).
+         */
+        public fun withChange(change: String): Builder {
+            spec = spec.copy(changes = spec.changes + change)
+
+            return this
+        }
+
+        public fun withLabel(key: String, value: String): Builder = withLabels(mapOf(key to value))
+        public fun withLabels(vararg labels: Pair<String, String>): Builder = withLabels(mapOf(*labels))
+
+        public fun withLabels(labels: Map<String, String>): Builder {
+            spec = spec.copy(labels = spec.labels + labels)
+
+            return this
+        }
+
+        /**
+         * Do not pause the container while the image is being created.
+         */
+        public fun withoutPausing(): Builder {
+            spec = spec.copy(pause = false)
+
+            return this
+        }
+
+        public fun build(): ContainerCommitSpec = spec
+    }
+}
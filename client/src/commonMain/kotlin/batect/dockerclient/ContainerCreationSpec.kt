@@ -57,6 +57,39 @@ public data class ContainerCreationSpec(
     val attachStdin: Boolean = false,
     val stdinOnce: Boolean = false,
     val openStdin: Boolean = false,
+    val memoryLimitInBytes: Long? = null,
+    val memoryReservationInBytes: Long? = null,
+    val memorySwapLimitInBytes: Long? = null,
+    val cpuCount: Double? = null,
+    val cpuQuotaInMicroseconds: Long? = null,
+    val cpuPeriodInMicroseconds: Long? = null,
+    val cpuShares: Long? = null,
+    val cpuSetCPUs: String? = null,
+    val cpuSetMemoryNodes: String? = null,
+    val pidsLimit: Long? = null,
+    val ulimits: Set<Ulimit> = emptySet(),
+    val blockIOWeight: Int? = null,
+    val mounts: Set<Mount> = emptySet(),
+    val readOnlyRootFilesystem: Boolean = false,
+    val noNewPrivileges: Boolean = false,
+    val seccompProfile: String? = null,
+    val appArmorProfile: String? = null,
+    val seLinuxLabels: Set<String> = emptySet(),
+    val sysctls: Map<String, String> = emptyMap(),
+    val usernsMode: String? = null,
+    val additionalGroups: Set<String> = emptySet(),
+    val oomScoreAdjustment: Int? = null,
+    val restartPolicy: RestartPolicy? = null,
+    val stopSignal: String? = null,
+    val stopTimeout: Duration? = null,
+    val networkAttachments: List<NetworkAttachment> = emptyList(),
+    val autoRemove: Boolean = false,
+    val platform: String? = null,
+    val ipcMode: String? = null,
+    val pidMode: String? = null,
+    val utsMode: String? = null,
+    val initialConsoleHeight: Long? = null,
+    val initialConsoleWidth: Long? = null,
 ) {
     internal fun ensureValid() {
         if (networkAliases.isNotEmpty() && network == null) {
@@ -161,6 +194,12 @@ public data class ContainerCreationSpec(
             return this
         }
 
+        public fun withMount(mount: Mount): Builder {
+            spec = spec.copy(mounts = spec.mounts + mount)
+
+            return this
+        }
+
         public fun withExposedPort(localPort: Long, containerPort: Long, protocol: String = ExposedPort.defaultProtocol): Builder =
             withExposedPort(ExposedPort(localPort, containerPort, protocol))
 
@@ -297,6 +336,251 @@ public data class ContainerCreationSpec(
             return this
         }
 
+        public fun withMemoryLimit(limitInBytes: Long): Builder {
+            spec = spec.copy(memoryLimitInBytes = limitInBytes)
+
+            return this
+        }
+
+        public fun withMemoryReservation(reservationInBytes: Long): Builder {
+            spec = spec.copy(memoryReservationInBytes = reservationInBytes)
+
+            return this
+        }
+
+        /**
+         * Sets the total amount of memory and swap the container can use, like the Docker CLI's `--memory-swap` option.
+         *
+         * Use -1 to allow unlimited swap.
+         */
+        public fun withMemorySwapLimit(limitInBytes: Long): Builder {
+            spec = spec.copy(memorySwapLimitInBytes = limitInBytes)
+
+            return this
+        }
+
+        public fun withCPUCount(cpus: Double): Builder {
+            spec = spec.copy(cpuCount = cpus)
+
+            return this
+        }
+
+        public fun withCPUQuota(quotaInMicroseconds: Long): Builder {
+            spec = spec.copy(cpuQuotaInMicroseconds = quotaInMicroseconds)
+
+            return this
+        }
+
+        public fun withCPUPeriod(periodInMicroseconds: Long): Builder {
+            spec = spec.copy(cpuPeriodInMicroseconds = periodInMicroseconds)
+
+            return this
+        }
+
+        public fun withCPUShares(shares: Long): Builder {
+            spec = spec.copy(cpuShares = shares)
+
+            return this
+        }
+
+        /**
+         * Restricts the container to the provided CPUs, in the same format as the Docker CLI's `--cpuset-cpus` option (for example, `0-2` or `1,3`).
+         */
+        public fun withCPUSet(cpus: String): Builder {
+            spec = spec.copy(cpuSetCPUs = cpus)
+
+            return this
+        }
+
+        /**
+         * Restricts the container to the provided memory nodes, in the same format as the Docker CLI's `--cpuset-mems` option (for example, `0-1`).
+         */
+        public fun withCPUSetMemoryNodes(nodes: String): Builder {
+            spec = spec.copy(cpuSetMemoryNodes = nodes)
+
+            return this
+        }
+
+        public fun withPidsLimit(limit: Long): Builder {
+            spec = spec.copy(pidsLimit = limit)
+
+            return this
+        }
+
+        public fun withUlimit(name: String, soft: Long, hard: Long): Builder = withUlimit(Ulimit(name, soft, hard))
+
+        public fun withUlimit(ulimit: Ulimit): Builder {
+            spec = spec.copy(ulimits = spec.ulimits + ulimit)
+
+            return this
+        }
+
+        public fun withBlockIOWeight(weight: Int): Builder {
+            spec = spec.copy(blockIOWeight = weight)
+
+            return this
+        }
+
+        public fun withReadOnlyRootFilesystem(): Builder {
+            spec = spec.copy(readOnlyRootFilesystem = true)
+
+            return this
+        }
+
+        public fun withNoNewPrivileges(): Builder {
+            spec = spec.copy(noNewPrivileges = true)
+
+            return this
+        }
+
+        /**
+         * Sets the seccomp profile for the container.
+         *
+         * [profile] must be either `unconfined` or the contents of a JSON seccomp profile (not the path to a profile).
+         */
+        public fun withSeccompProfile(profile: String): Builder {
+            spec = spec.copy(seccompProfile = profile)
+
+            return this
+        }
+
+        public fun withAppArmorProfile(profile: String): Builder {
+            spec = spec.copy(appArmorProfile = profile)
+
+            return this
+        }
+
+        /**
+         * Adds a SELinux label option for the container, in the same format as the Docker CLI's `--security-opt label=...` option
+         * (for example, `type:svirt_apache_t` or `disable`).
+         */
+        public fun withSELinuxLabel(label: String): Builder {
+            spec = spec.copy(seLinuxLabels = spec.seLinuxLabels + label)
+
+            return this
+        }
+
+        public fun withSysctl(name: String, value: String): Builder = withSysctls(mapOf(name to value))
+
+        public fun withSysctls(sysctls: Map<String, String>): Builder {
+            spec = spec.copy(sysctls = spec.sysctls + sysctls)
+
+            return this
+        }
+
+        public fun withUsernsMode(mode: String): Builder {
+            spec = spec.copy(usernsMode = mode)
+
+            return this
+        }
+
+        /**
+         * Adds an additional group for the container's user, like the Docker CLI's `--group-add` option.
+         *
+         * [group] can be either a group name or a group ID.
+         */
+        public fun withAdditionalGroup(group: String): Builder {
+            spec = spec.copy(additionalGroups = spec.additionalGroups + group)
+
+            return this
+        }
+
+        public fun withOOMScoreAdjustment(adjustment: Int): Builder {
+            spec = spec.copy(oomScoreAdjustment = adjustment)
+
+            return this
+        }
+
+        public fun withRestartPolicy(name: String, maximumRetryCount: Long = 0): Builder = withRestartPolicy(RestartPolicy(name, maximumRetryCount))
+
+        public fun withRestartPolicy(policy: RestartPolicy): Builder {
+            spec = spec.copy(restartPolicy = policy)
+
+            return this
+        }
+
+        public fun withStopSignal(signal: String): Builder {
+            spec = spec.copy(stopSignal = signal)
+
+            return this
+        }
+
+        /**
+         * Sets the time to wait for the container to stop after sending the stop signal before killing it.
+         *
+         * Note that the timeout is passed to Docker in whole seconds.
+         */
+        public fun withStopTimeout(timeout: Duration): Builder {
+            spec = spec.copy(stopTimeout = timeout)
+
+            return this
+        }
+
+        public fun withNetworkAttachment(network: NetworkReference, aliases: Set<String> = emptySet()): Builder =
+            withNetworkAttachment(NetworkAttachment(network, aliases))
+
+        /**
+         * Connects the container to an additional network.
+         *
+         * If no network is set with [withNetwork], the first network attachment is used as the container's primary network.
+         */
+        public fun withNetworkAttachment(attachment: NetworkAttachment): Builder {
+            spec = spec.copy(networkAttachments = spec.networkAttachments + attachment)
+
+            return this
+        }
+
+        public fun withAutoRemove(): Builder {
+            spec = spec.copy(autoRemove = true)
+
+            return this
+        }
+
+        /**
+         * Sets the platform for the container, in the same format as the Docker CLI's `--platform` option (for example, `linux/arm64` or `linux/arm/v7`).
+         */
+        public fun withPlatform(platform: String): Builder {
+            spec = spec.copy(platform = platform)
+
+            return this
+        }
+
+        /**
+         * Sets the IPC namespace mode for the container, like the Docker CLI's `--ipc` option (for example, `host` or `container:<name or ID>`).
+         */
+        public fun withIPCMode(mode: String): Builder {
+            spec = spec.copy(ipcMode = mode)
+
+            return this
+        }
+
+        /**
+         * Sets the PID namespace mode for the container, like the Docker CLI's `--pid` option (for example, `host` or `container:<name or ID>`).
+         */
+        public fun withPIDMode(mode: String): Builder {
+            spec = spec.copy(pidMode = mode)
+
+            return this
+        }
+
+        /**
+         * Sets the UTS namespace mode for the container, like the Docker CLI's `--uts` option (for example, `host`).
+         */
+        public fun withUTSMode(mode: String): Builder {
+            spec = spec.copy(utsMode = mode)
+
+            return this
+        }
+
+        /**
+         * Sets the size of the TTY when the container starts. Has no effect if [withTTY] is not used.
+         */
+        public fun withInitialConsoleSize(height: Long, width: Long): Builder {
+            spec = spec.copy(initialConsoleHeight = height, initialConsoleWidth = width)
+
+            return this
+        }
+
         public fun build(): ContainerCreationSpec = spec
     }
 }
@@ -308,6 +592,22 @@ public data class ContainerCreationSpec(
  */
 public data class ExtraHost(val hostname: String, val address: String)
 
+/**
+ * A network a container is connected to, and the container's settings on that network.
+ *
+ * [links] are legacy container links, in the same format as the Docker CLI's `--link` option (for example, `other-container:alias`).
+ *
+ * @see [ContainerCreationSpec.Builder.withNetworkAttachment]
+ * @see [DockerClient.connectContainerToNetwork]
+ */
+public data class NetworkAttachment(
+    val network: NetworkReference,
+    val aliases: Set<String> = emptySet(),
+    val ipv4Address: String? = null,
+    val ipv6Address: String? = null,
+    val links: Set<String> = emptySet(),
+)
+
 /**
  * Common properties for all forms of mounts into containers: [HostMount], [VolumeMount], [TmpfsMount] and [DeviceMount].
  */
@@ -361,16 +661,45 @@ public data class DeviceMount(val localPath: Path, override val containerPath: S
 }
 
 /**
- * A port exposed from a container to the host.
+ * A port or range of ports exposed from a container to the host.
+ *
+ * If [localPort] is 0, Docker chooses a free port on the host. Use [DockerClient.inspectContainer] to find the port chosen.
+ *
+ * To expose a range of ports, set [localPortRangeEnd] and [containerPortRangeEnd] to the last port in each range (inclusive).
+ *
+ * If [localIP] is set, the port is only exposed on that address on the host.
  *
  * @see [ContainerCreationSpec.Builder.withExposedPort]
  */
-public data class ExposedPort(val localPort: Long, val containerPort: Long, val protocol: String = defaultProtocol) {
+public data class ExposedPort(
+    val localPort: Long,
+    val containerPort: Long,
+    val protocol: String = defaultProtocol,
+    val localIP: String? = null,
+    val localPortRangeEnd: Long? = null,
+    val containerPortRangeEnd: Long? = null,
+) {
     public companion object {
         public const val defaultProtocol: String = "tcp"
     }
 }
 
+/**
+ * A resource limit for processes in a container, like the Docker CLI's `--ulimit` option.
+ *
+ * @see [ContainerCreationSpec.Builder.withUlimit]
+ */
+public data class Ulimit(val name: String, val soft: Long, val hard: Long)
+
+/**
+ * The restart policy for a container.
+ *
+ * [name] is one of `no`, `always`, `unless-stopped` or `on-failure`. [maximumRetryCount] only applies to the `on-failure` policy.
+ *
+ * @see [ContainerCreationSpec.Builder.withRestartPolicy]
+ */
+public data class RestartPolicy(val name: String, val maximumRetryCount: Long = 0)
+
 /**
  * A Unix user and group used to run the container or exec instance.
  *
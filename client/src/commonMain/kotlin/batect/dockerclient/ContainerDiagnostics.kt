@@ -0,0 +1,50 @@
+/*
+    Copyright 2017-2022 Charles Korn.
+
+    Licensed under the Apache License, Version 2.0 (the "License");
+    you may not use this file except in compliance with the License.
+    You may obtain a copy of the License at
+
+        https://www.apache.org/licenses/LICENSE-2.0
+
+    Unless required by applicable law or agreed to in writing, software
+    distributed under the License is distributed on an "AS IS" BASIS,
+    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+    See the License for the specific language governing permissions and
+    limitations under the License.
+*/
+
+package batect.dockerclient
+
+/**
+ * A change made to a container's filesystem since it was created.
+ *
+ * [kind] is one of `added`, `modified` or `deleted`.
+ *
+ * @see [DockerClient.listContainerFilesystemChanges]
+ */
+public data class ContainerFilesystemChange(
+    val path: String,
+    val kind: String,
+)
+
+/**
+ * The processes running in a container, as reported by `ps`.
+ *
+ * Each process has one value for each of the columns in [titles].
+ *
+ * @see [DockerClient.listContainerProcesses]
+ */
+public data class ContainerProcessList(
+    val titles: List<String>,
+    val processes: List<ContainerProcess>,
+)
+
+/**
+ * A single process running in a container.
+ *
+ * @see [ContainerProcessList]
+ */
+public data class ContainerProcess(
+    val values: List<String>,
+)
@@ -32,6 +32,8 @@ public data class ContainerExecSpec(
     val workingDirectory: String? = null,
     val userAndGroup: UserAndGroup? = null,
     val privileged: Boolean = false,
+    val initialConsoleHeight: Long? = null,
+    val initialConsoleWidth: Long? = null,
 ) {
     internal val environmentVariablesFormattedForDocker: List<String> = environmentVariables.map { "${it.key}=${it.value}" }
     internal val userAndGroupFormattedForDocker: String? = if (userAndGroup == null) null else "${userAndGroup.uid}:${userAndGroup.gid}"
@@ -105,6 +107,15 @@ public data class ContainerExecSpec(
             return this
         }
 
+        /**
+         * Sets the size of the TTY when the exec instance starts. Has no effect if [withTTYAttached] is not used.
+         */
+        public fun withInitialConsoleSize(height: Long, width: Long): Builder {
+            spec = spec.copy(initialConsoleHeight = height, initialConsoleWidth = width)
+
+            return this
+        }
+
         public fun build(): ContainerExecSpec = spec
     }
 }
@@ -0,0 +1,48 @@
+/*
+    Copyright 2017-2022 Charles Korn.
+
+    Licensed under the Apache License, Version 2.0 (the "License");
+    you may not use this file except in compliance with the License.
+    You may obtain a copy of the License at
+
+        https://www.apache.org/licenses/LICENSE-2.0
+
+    Unless required by applicable law or agreed to in writing, software
+    distributed under the License is distributed on an "AS IS" BASIS,
+    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+    See the License for the specific language governing permissions and
+    limitations under the License.
+*/
+
+package batect.dockerclient
+
+/**
+ * The result of waiting for a container to become healthy.
+ *
+ * @see [DockerClient.waitForContainerToBeHealthy]
+ */
+public sealed interface ContainerHealthWaitResult
+
+/**
+ * The container's health check reported that it is healthy.
+ */
+public object ContainerBecameHealthy : ContainerHealthWaitResult
+
+/**
+ * The container's health check reported that it is unhealthy.
+ *
+ * [lastHealthcheckOutput] is the output of the most recent health check, which can help explain why the container is unhealthy.
+ */
+public data class ContainerBecameUnhealthy(val lastHealthcheckOutput: String) : ContainerHealthWaitResult
+
+/**
+ * The container exited before its health check reported that it was healthy or unhealthy.
+ */
+public data class ContainerExitedBeforeBecomingHealthy(val exitCode: Long) : ContainerHealthWaitResult
+
+internal fun ContainerHealthWaitResult(outcome: String, lastHealthcheckOutput: String, exitCode: Long): ContainerHealthWaitResult = when (outcome) {
+    "healthy" -> ContainerBecameHealthy
+    "unhealthy" -> ContainerBecameUnhealthy(lastHealthcheckOutput)
+    "exited" -> ContainerExitedBeforeBecomingHealthy(exitCode)
+    else -> throw DockerClientException("Unknown health wait outcome '$outcome'")
+}
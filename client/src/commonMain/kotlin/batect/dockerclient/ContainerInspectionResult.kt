@@ -30,6 +30,11 @@ public data class ContainerInspectionResult(
     val hostConfig: ContainerHostConfig,
     val state: ContainerState,
     val config: ContainerConfig,
+    val networkSettings: ContainerNetworkSettings,
+    val created: Instant,
+    val imageID: String,
+    val restartCount: Long,
+    val mounts: List<ContainerMountPoint>,
 )
 
 /**
@@ -40,6 +45,59 @@ public data class ContainerInspectionResult(
  */
 public data class ContainerHostConfig(
     val logConfig: ContainerLogConfig,
+    val restartPolicy: RestartPolicy,
+)
+
+/**
+ * Contains network configuration for a container.
+ *
+ * [ports] contains the host ports bound for each exposed port, including those chosen by Docker.
+ *
+ * @see [ContainerInspectionResult]
+ * @see [DockerClient.inspectContainer]
+ */
+public data class ContainerNetworkSettings(
+    val ports: List<PortBinding>,
+    val networks: List<ContainerNetworkEndpoint>,
+)
+
+/**
+ * Contains details of a container's connection to a single network.
+ *
+ * @see [ContainerNetworkSettings]
+ * @see [DockerClient.inspectContainer]
+ */
+public data class ContainerNetworkEndpoint(
+    val networkName: String,
+    val networkID: String,
+    val endpointID: String,
+    val aliases: List<String>,
+    val ipAddress: String,
+    val ipPrefixLength: Long,
+    val gateway: String,
+    val globalIPv6Address: String,
+    val globalIPv6PrefixLength: Long,
+    val ipv6Gateway: String,
+    val macAddress: String,
+)
+
+/**
+ * Contains details of a mount into a container.
+ *
+ * [name] and [driver] are only set for volume mounts.
+ *
+ * @see [ContainerInspectionResult]
+ * @see [DockerClient.inspectContainer]
+ */
+public data class ContainerMountPoint(
+    val type: String,
+    val name: String,
+    val source: String,
+    val destination: String,
+    val driver: String,
+    val mode: String,
+    val readWrite: Boolean,
+    val propagation: String,
 )
 
 /**
@@ -56,11 +114,24 @@ public data class ContainerLogConfig(
 /**
  * Contains a snapshot of state information for a container.
  *
+ * [startedAt] and [finishedAt] are `null` if the container has never started or finished.
+ *
  * @see [ContainerInspectionResult]
  * @see [DockerClient.inspectContainer]
  */
 public data class ContainerState(
     val health: ContainerHealthState?,
+    val status: String,
+    val running: Boolean,
+    val paused: Boolean,
+    val restarting: Boolean,
+    val oomKilled: Boolean,
+    val dead: Boolean,
+    val pid: Long,
+    val exitCode: Long,
+    val error: String,
+    val startedAt: Instant?,
+    val finishedAt: Instant?,
 )
 
 /**
@@ -96,6 +167,14 @@ public data class ContainerHealthLogEntry(
 public data class ContainerConfig(
     val labels: Map<String, String>,
     val healthcheck: ContainerHealthcheckConfig?,
+    val stopSignal: String?,
+    val stopTimeout: Duration?,
+    val image: String,
+    val environmentVariables: Map<String, String>,
+    val command: List<String>,
+    val entrypoint: List<String>,
+    val workingDirectory: String,
+    val user: String,
 )
 
 /**
@@ -111,3 +190,6 @@ public data class ContainerHealthcheckConfig(
     val startPeriod: Duration?,
     val retries: Int?,
 )
+
+internal fun environmentVariablesToMap(variables: List<String>): Map<String, String> =
+    variables.associate { it.substringBefore('=') to it.substringAfter('=', "") }
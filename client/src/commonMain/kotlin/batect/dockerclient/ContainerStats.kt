@@ -0,0 +1,53 @@
+/*
+    Copyright 2017-2022 Charles Korn.
+
+    Licensed under the Apache License, Version 2.0 (the "License");
+    you may not use this file except in compliance with the License.
+    You may obtain a copy of the License at
+
+        https://www.apache.org/licenses/LICENSE-2.0
+
+    Unless required by applicable law or agreed to in writing, software
+    distributed under the License is distributed on an "AS IS" BASIS,
+    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+    See the License for the specific language governing permissions and
+    limitations under the License.
+*/
+
+package batect.dockerclient
+
+import kotlinx.datetime.Instant
+
+/**
+ * A snapshot of the resources used by a container.
+ *
+ * Totals are cumulative since the container started. Deltas are relative to the previous sample, and are zero for the first sample.
+ *
+ * @see [DockerClient.streamContainerStats]
+ */
+public data class ContainerStatsSample(
+    val timestamp: Instant,
+    val cpuPercentage: Double,
+    val memoryUsageBytes: Long,
+    val memoryLimitBytes: Long,
+    val networkReceivedBytes: Long,
+    val networkTransmittedBytes: Long,
+    val networkReceivedBytesDelta: Long,
+    val networkTransmittedBytesDelta: Long,
+    val blockIOReadBytes: Long,
+    val blockIOWrittenBytes: Long,
+    val blockIOReadBytesDelta: Long,
+    val blockIOWrittenBytesDelta: Long,
+)
+
+/**
+ * Returned from a [ContainerStatsHandler] to indicate whether the handler would like to continue receiving further samples.
+ *
+ * @see [DockerClient.streamContainerStats]
+ */
+public enum class ContainerStatsHandlerAction {
+    ContinueStreaming,
+    Stop,
+}
+
+public typealias ContainerStatsHandler = (ContainerStatsSample) -> ContainerStatsHandlerAction
@@ -0,0 +1,42 @@
+/*
+    Copyright 2017-2022 Charles Korn.
+
+    Licensed under the Apache License, Version 2.0 (the "License");
+    you may not use this file except in compliance with the License.
+    You may obtain a copy of the License at
+
+        https://www.apache.org/licenses/LICENSE-2.0
+
+    Unless required by applicable law or agreed to in writing, software
+    distributed under the License is distributed on an "AS IS" BASIS,
+    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+    See the License for the specific language governing permissions and
+    limitations under the License.
+*/
+
+package batect.dockerclient
+
+/**
+ * Contains summary information about a container.
+ *
+ * @see [DockerClient.listContainers]
+ */
+public data class ContainerSummary(
+    val reference: ContainerReference,
+    val names: List<String>,
+    val image: String,
+    val labels: Map<String, String>,
+    val state: String,
+    val status: String,
+    val ports: List<PortBinding>,
+)
+
+/**
+ * The outcome of removing stopped containers.
+ *
+ * @see [DockerClient.pruneContainers]
+ */
+public data class ContainerPruneResult(
+    val containersRemoved: List<ContainerReference>,
+    val spaceReclaimedInBytes: Long,
+)
@@ -0,0 +1,129 @@
+/*
+    Copyright 2017-2022 Charles Korn.
+
+    Licensed under the Apache License, Version 2.0 (the "License");
+    you may not use this file except in compliance with the License.
+    You may obtain a copy of the License at
+
+        https://www.apache.org/licenses/LICENSE-2.0
+
+    Unless required by applicable law or agreed to in writing, software
+    distributed under the License is distributed on an "AS IS" BASIS,
+    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+    See the License for the specific language governing permissions and
+    limitations under the License.
+*/
+
+package batect.dockerclient
+
+/**
+ * A set of changes to apply to the resource limits and restart policy of an existing container.
+ *
+ * Any settings left as `null` are not changed.
+ *
+ * @see [DockerClient.updateContainer]
+ */
+public data class ContainerUpdateSpec(
+    val memoryLimitInBytes: Long? = null,
+    val memoryReservationInBytes: Long? = null,
+    val memorySwapLimitInBytes: Long? = null,
+    val cpuCount: Double? = null,
+    val cpuQuotaInMicroseconds: Long? = null,
+    val cpuPeriodInMicroseconds: Long? = null,
+    val cpuShares: Long? = null,
+    val cpuSetCPUs: String? = null,
+    val cpuSetMemoryNodes: String? = null,
+    val pidsLimit: Long? = null,
+    val blockIOWeight: Int? = null,
+    val restartPolicy: RestartPolicy? = null,
+) {
+    /**
+     * Builder to create an instance of a [ContainerUpdateSpec] for use with [DockerClient.updateContainer].
+     *
+     * @see [DockerClient.updateContainer]
+     */
+    public class Builder {
+        private var spec = ContainerUpdateSpec()
+
+        public fun withMemoryLimit(limitInBytes: Long): Builder {
+            spec = spec.copy(memoryLimitInBytes = limitInBytes)
+
+            return this
+        }
+
+        public fun withMemoryReservation(reservationInBytes: Long): Builder {
+            spec = spec.copy(memoryReservationInBytes = reservationInBytes)
+
+            return this
+        }
+
+        /**
+         * Sets the total amount of memory and swap the container can use, like the Docker CLI's `--memory-swap` option.
+         *
+         * Use -1 to allow unlimited swap.
+         */
+        public fun withMemorySwapLimit(limitInBytes: Long): Builder {
+            spec = spec.copy(memorySwapLimitInBytes = limitInBytes)
+
+            return this
+        }
+
+        public fun withCPUCount(cpus: Double): Builder {
+            spec = spec.copy(cpuCount = cpus)
+
+            return this
+        }
+
+        public fun withCPUQuota(quotaInMicroseconds: Long): Builder {
+            spec = spec.copy(cpuQuotaInMicroseconds = quotaInMicroseconds)
+
+            return this
+        }
+
+        public fun withCPUPeriod(periodInMicroseconds: Long): Builder {
+            spec = spec.copy(cpuPeriodInMicroseconds = periodInMicroseconds)
+
+            return this
+        }
+
+        public fun withCPUShares(shares: Long): Builder {
+            spec = spec.copy(cpuShares = shares)
+
+            return this
+        }
+
+        public fun withCPUSet(cpus: String): Builder {
+            spec = spec.copy(cpuSetCPUs = cpus)
+
+            return this
+        }
+
+        public fun withCPUSetMemoryNodes(nodes: String): Builder {
+            spec = spec.copy(cpuSetMemoryNodes = nodes)
+
+            return this
+        }
+
+        public fun withPidsLimit(limit: Long): Builder {
+            spec = spec.copy(pidsLimit = limit)
+
+            return this
+        }
+
+        public fun withBlockIOWeight(weight: Int): Builder {
+            spec = spec.copy(blockIOWeight = weight)
+
+            return this
+        }
+
+        public fun withRestartPolicy(name: String, maximumRetryCount: Long = 0): Builder = withRestartPolicy(RestartPolicy(name, maximumRetryCount))
+
+        public fun withRestartPolicy(policy: RestartPolicy): Builder {
+            spec = spec.copy(restartPolicy = policy)
+
+            return this
+        }
+
+        public fun build(): ContainerUpdateSpec = spec
+    }
+}
@@ -22,6 +22,7 @@ import kotlinx.coroutines.async
 import kotlinx.coroutines.coroutineScope
 import kotlinx.coroutines.launch
 import kotlinx.datetime.Instant
+import okio.Path
 import kotlin.time.Duration
 
 /**
@@ -45,19 +46,239 @@ public interface DockerClient : AutoCloseable {
     public suspend fun deleteNetwork(network: NetworkReference)
     public suspend fun getNetworkByNameOrID(searchFor: String): NetworkReference?
 
-    public suspend fun pullImage(name: String, onProgressUpdate: ImagePullProgressReceiver = {}): ImageReference
+    /**
+     * Connects the provided container to a network, like `docker network connect`.
+     *
+     * @param container the container to connect
+     * @param attachment the network to connect to and the container's settings on that network
+     */
+    public suspend fun connectContainerToNetwork(container: ContainerReference, attachment: NetworkAttachment)
+
+    /**
+     * Disconnects the provided container from a network, like `docker network disconnect`.
+     *
+     * @param container the container to disconnect
+     * @param network the network to disconnect from
+     * @param force if `true`, disconnect the container even if it is not running
+     */
+    public suspend fun disconnectContainerFromNetwork(container: ContainerReference, network: NetworkReference, force: Boolean = false)
+
+    /**
+     * Pulls the image [name] for the daemon's platform.
+     *
+     * @param name the image to pull
+     * @param onProgressUpdate receives progress information while the image is pulled
+     * @return the pulled image
+     */
+    public suspend fun pullImage(name: String, onProgressUpdate: ImagePullProgressReceiver = {}): ImageReference = pullImage(name, null, onProgressUpdate)
+
+    /**
+     * Pulls the image [name] for [platform].
+     *
+     * @param name the image to pull
+     * @param platform the platform to pull the image for, in the same format as the Docker CLI's `--platform` option (for example, `linux/arm64`).
+     * If `null`, the daemon's platform is used.
+     * @param onProgressUpdate receives progress information while the image is pulled
+     * @return the pulled image
+     */
+    public suspend fun pullImage(name: String, platform: String?, onProgressUpdate: ImagePullProgressReceiver = {}): ImageReference
+
+    /**
+     * Pulls every tagged image in the repository [name] for the daemon's platform, like `docker pull --all-tags`.
+     *
+     * @param name the repository to pull, without a tag or digest
+     * @param onProgressUpdate receives progress information while the images are pulled
+     * @return the pulled images
+     */
+    public suspend fun pullAllImageTags(name: String, onProgressUpdate: ImagePullProgressReceiver = {}): Set<ImageReference> = pullAllImageTags(name, null, onProgressUpdate)
+
+    /**
+     * Pulls every tagged image in the repository [name] for [platform], like `docker pull --all-tags --platform`.
+     *
+     * @param name the repository to pull, without a tag or digest
+     * @param platform the platform to pull each image for, in the same format as the Docker CLI's `--platform` option (for example, `linux/arm64`).
+     * If `null`, the daemon's platform is used.
+     * @param onProgressUpdate receives progress information while the images are pulled
+     * @return the pulled images
+     */
+    public suspend fun pullAllImageTags(name: String, platform: String?, onProgressUpdate: ImagePullProgressReceiver = {}): Set<ImageReference>
+
+    /**
+     * Pushes an image to a registry, like `docker push`.
+     *
+     * Credentials for the registry are taken from the Docker CLI's configuration, in the same way as [pullImage].
+     *
+     * @param name the image to push, including the tag to push (for example, `my-registry.example.com/my-image:1.0`)
+     * @param onProgressUpdate receives progress information while the image is pushed
+     * @return the digest of the pushed image, for example `sha256:abc123...`
+     */
+    public suspend fun pushImage(name: String, onProgressUpdate: ImagePullProgressReceiver = {}): String
     public suspend fun deleteImage(image: ImageReference, force: Boolean = false)
     public suspend fun getImage(name: String): ImageReference?
 
+    /**
+     * Returns detailed information about an image, like `docker image inspect`.
+     *
+     * @param nameOrID the name, tag, digest or ID of the image
+     * @throws ImageInspectionFailedException if the image does not exist
+     */
+    public suspend fun inspectImage(nameOrID: String): ImageInspectionResult
+    public suspend fun inspectImage(image: ImageReference): ImageInspectionResult = inspectImage(image.id)
+
+    /**
+     * Lists images, like `docker image ls`.
+     *
+     * @param all if `true`, include intermediate images, otherwise only top-level images are returned
+     * @param filters filters to apply, in the same format as the Docker CLI's `--filter` option (for example, `label` to `setOf("my-label=value")`)
+     * @return the matching images
+     */
+    public suspend fun listImages(all: Boolean = false, filters: Map<String, Set<String>> = emptyMap()): List<ImageSummary>
+
+    /**
+     * Saves one or more images as a tar archive, like `docker image save`.
+     *
+     * @param names the names, tags or IDs of the images to save
+     * @param output the output stream to write the archive to
+     */
+    public suspend fun saveImages(names: Set<String>, output: TextOutput)
+
+    /**
+     * Saves one or more images as a tar archive to a file, like `docker image save --output`.
+     *
+     * @param names the names, tags or IDs of the images to save
+     * @param destination the file to write the archive to. It will be overwritten if it already exists.
+     */
+    public suspend fun saveImagesToFile(names: Set<String>, destination: Path)
+
+    /**
+     * Loads images from a tar archive, like `docker image load`.
+     *
+     * @param input the archive to load, for example one created by [saveImages]
+     * @return the loaded images: the tag of each tagged image (for example, `alpine:3.18`), or the ID of each untagged image
+     */
+    public suspend fun loadImages(input: TextInput): List<String>
+
+    /**
+     * Loads images from a tar archive file, like `docker image load --input`.
+     *
+     * @param source the archive to load, for example one created by [saveImagesToFile]
+     * @return the loaded images: the tag of each tagged image (for example, `alpine:3.18`), or the ID of each untagged image
+     */
+    public suspend fun loadImagesFromFile(source: Path): List<String>
+
     public suspend fun buildImage(spec: ImageBuildSpec, output: TextOutput, onProgressUpdate: ImageBuildProgressReceiver = {}): ImageReference
     public suspend fun pruneImageBuildCache()
 
     public suspend fun createContainer(spec: ContainerCreationSpec): ContainerReference
     public suspend fun startContainer(container: ContainerReference)
     public suspend fun stopContainer(container: ContainerReference, timeout: Duration)
+    public suspend fun restartContainer(container: ContainerReference, timeout: Duration)
+
+    /**
+     * Sends a signal to the main process of the provided container, like `docker kill`.
+     *
+     * @param container the container to signal
+     * @param signal the signal to send, either as a name (for example, `SIGTERM` or `TERM`) or a number (for example, `15`)
+     */
+    public suspend fun killContainer(container: ContainerReference, signal: String = "SIGKILL")
+    public suspend fun pauseContainer(container: ContainerReference)
+    public suspend fun unpauseContainer(container: ContainerReference)
     public suspend fun removeContainer(container: ContainerReference, force: Boolean = false, removeVolumes: Boolean = false)
+
+    /**
+     * Renames an existing container.
+     *
+     * @throws ContainerRenameFailedException if the container does not exist or the new name is already in use
+     */
+    public suspend fun renameContainer(container: ContainerReference, newName: String)
+
+    /**
+     * Updates the resource limits and restart policy of an existing container.
+     *
+     * Any settings not provided in [update] are left unchanged.
+     */
+    public suspend fun updateContainer(container: ContainerReference, update: ContainerUpdateSpec)
+
+    /**
+     * Creates a new image from the current state of a container's filesystem, like `docker commit`.
+     *
+     * @return a reference to the new image
+     */
+    public suspend fun commitContainer(spec: ContainerCommitSpec): ImageReference
     public suspend fun uploadToContainer(container: ContainerReference, items: Set<UploadItem>, destinationPath: String)
 
+    /**
+     * Uploads files and directories from the host to a container, streaming their contents rather than loading them into memory.
+     *
+     * @param container the container to upload to
+     * @param paths the files and directories to upload
+     * @param destinationPath the existing directory in the container to upload to
+     * @param followSymlinks if `true`, upload the files and directories that symbolic links point to, rather than the links themselves
+     */
+    public suspend fun uploadPathsToContainer(container: ContainerReference, paths: Set<UploadPath>, destinationPath: String, followSymlinks: Boolean = false)
+
+    /**
+     * Copies a file or directory out of a container into a directory on the host, like `docker cp`.
+     *
+     * @param container the container to copy from
+     * @param sourcePath the path of the file or directory in the container
+     * @param destinationDirectory the existing directory on the host to extract the file or directory into
+     * @param preserveOwnership if `true`, extracted files keep the owner and group they have in the container, otherwise they are owned by the current user
+     */
+    public suspend fun downloadFromContainer(container: ContainerReference, sourcePath: String, destinationDirectory: Path, preserveOwnership: Boolean = false)
+
+    /**
+     * Streams a file or directory from a container as a tar archive.
+     *
+     * @param container the container to copy from
+     * @param sourcePath the path of the file or directory in the container
+     * @param output the output stream to write the tar archive to
+     */
+    public suspend fun downloadArchiveFromContainer(container: ContainerReference, sourcePath: String, output: TextOutput)
+
+    /**
+     * Lists the files and directories that have been added, modified or deleted in a container's filesystem since it was created.
+     */
+    public suspend fun listContainerFilesystemChanges(container: ContainerReference): List<ContainerFilesystemChange>
+
+    /**
+     * Lists the processes running in a container, like `docker top`.
+     *
+     * @param psArguments arguments to pass to `ps`, for example `-eo pid,comm`. If empty, the daemon's default arguments are used.
+     */
+    public suspend fun listContainerProcesses(container: ContainerReference, psArguments: String = ""): ContainerProcessList
+
+    /**
+     * Exports the contents of a container's filesystem as a tar archive, like `docker export`.
+     *
+     * @param output the output stream to write the archive to
+     */
+    public suspend fun exportContainer(container: ContainerReference, output: TextOutput)
+
+    /**
+     * Exports the contents of a container's filesystem as a tar archive to a file, like `docker export --output`.
+     *
+     * @param destination the file to write the archive to. It will be overwritten if it already exists.
+     */
+    public suspend fun exportContainerToFile(container: ContainerReference, destination: Path)
+
+    /**
+     * Lists containers, like `docker ps`.
+     *
+     * @param all if `true`, include stopped containers, otherwise only running containers are returned
+     * @param filters filters to apply, in the same format as the Docker CLI's `--filter` option (for example, `label` to `setOf("my-label=value")`)
+     * @return the matching containers
+     */
+    public suspend fun listContainers(all: Boolean = false, filters: Map<String, Set<String>> = emptyMap()): List<ContainerSummary>
+
+    /**
+     * Removes all stopped containers, like `docker container prune`.
+     *
+     * @param filters filters to apply, in the same format as the Docker CLI's `--filter` option (for example, `label` to `setOf("my-label=value")`)
+     * @return the containers removed and the disk space reclaimed
+     */
+    public suspend fun pruneContainers(filters: Map<String, Set<String>> = emptyMap()): ContainerPruneResult
+
     /**
      * Streams input and output to/from the provided container.
      *
@@ -76,8 +297,54 @@ public interface DockerClient : AutoCloseable {
      * @param stderr the output stream to stream stderr to. Not used if the container is configured to use a TTY with [ContainerCreationSpec.Builder.withTTY].
      * @param stdin the input stream to stream stdin from. Only used if the container is configured to have stdin attached with [ContainerCreationSpec.Builder.withStdinAttached].
      * @param attachedNotification marked as ready when streaming has been established
+     * @param includeHistoricalOutput if `true`, replay any output the container has already produced before streaming new output
+     * @param detachKeys the key sequence that detaches from the container without stopping it, in the same format as the Docker CLI's `--detach-keys` option.
+     * Only used if the container is configured to use a TTY with [ContainerCreationSpec.Builder.withTTY]. If `null`, the default of `ctrl-p,ctrl-q` is used.
      */
-    public suspend fun attachToContainerIO(container: ContainerReference, stdout: TextOutput?, stderr: TextOutput?, stdin: TextInput?, attachedNotification: ReadyNotification? = null)
+    public suspend fun attachToContainerIO(
+        container: ContainerReference,
+        stdout: TextOutput?,
+        stderr: TextOutput?,
+        stdin: TextInput?,
+        attachedNotification: ReadyNotification? = null,
+        includeHistoricalOutput: Boolean = true,
+        detachKeys: String? = null,
+    )
+
+    /**
+     * Streams the logs of the provided container, like `docker logs`.
+     *
+     * Unlike [attachToContainerIO], this can be used after the container has exited, and can limit the output returned.
+     *
+     * @param container the container to stream logs from
+     * @param stdout the output stream to stream stdout to. If `null`, stdout is not streamed.
+     * @param stderr the output stream to stream stderr to. If `null`, stderr is not streamed. Not used if the container is configured to use a TTY with [ContainerCreationSpec.Builder.withTTY].
+     * @param follow if `true`, continue streaming new output until the container exits
+     * @param showTimestamps if `true`, prefix each line with the time it was produced
+     * @param tailLines if not `null`, only stream this many lines from the end of the existing logs
+     * @param since if not `null`, only stream output produced at or after this time
+     * @param until if not `null`, only stream output produced before this time
+     */
+    public suspend fun streamContainerLogs(
+        container: ContainerReference,
+        stdout: TextOutput?,
+        stderr: TextOutput?,
+        follow: Boolean = false,
+        showTimestamps: Boolean = false,
+        tailLines: Long? = null,
+        since: Instant? = null,
+        until: Instant? = null,
+    )
+
+    /**
+     * Streams resource usage statistics for the provided container, like `docker stats`.
+     *
+     * Streaming continues until [onStatsReceived] returns [ContainerStatsHandlerAction.Stop], the calling coroutine is cancelled or the daemon stops sending statistics.
+     *
+     * @param container the container to stream statistics for
+     * @param onStatsReceived receives each sample as it arrives
+     */
+    public suspend fun streamContainerStats(container: ContainerReference, onStatsReceived: ContainerStatsHandler)
 
     public suspend fun inspectContainer(idOrName: String): ContainerInspectionResult
     public suspend fun inspectContainer(container: ContainerReference): ContainerInspectionResult = inspectContainer(container.id)
@@ -89,10 +356,33 @@ public interface DockerClient : AutoCloseable {
      */
     public suspend fun waitForContainerToExit(container: ContainerReference, waitingNotification: ReadyNotification? = null): Long
 
+    /**
+     * Wait for a container's health check to report that it is healthy or unhealthy, or for the container to exit.
+     *
+     * If the container is already healthy, unhealthy or has exited, this returns immediately.
+     *
+     * @throws ContainerHealthWaitFailedException if the container does not have a health check
+     */
+    public suspend fun waitForContainerToBeHealthy(container: ContainerReference): ContainerHealthWaitResult
+
     public suspend fun createExec(spec: ContainerExecSpec): ContainerExecReference
     public suspend fun startExecDetached(exec: ContainerExecReference)
     public suspend fun inspectExec(exec: ContainerExecReference): ContainerExecInspectionResult
 
+    /**
+     * Resizes the TTY attached to a running container.
+     *
+     * @throws ContainerTTYResizeFailedException if the container does not have a TTY, is not running, or the size is invalid
+     */
+    public suspend fun resizeContainerTTY(container: ContainerReference, height: Long, width: Long)
+
+    /**
+     * Resizes the TTY attached to a running exec instance.
+     *
+     * @throws ExecTTYResizeFailedException if the exec instance does not have a TTY, is not running, or the size is invalid
+     */
+    public suspend fun resizeExecTTY(exec: ContainerExecReference, height: Long, width: Long)
+
     /**
      * Starts the provided exec instance and then streams input and output to and from it.
      *
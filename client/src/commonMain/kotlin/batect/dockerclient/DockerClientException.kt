@@ -271,3 +271,228 @@ public expect class StartingContainerExecFailedException(
     cause: Throwable? = null,
     golangErrorType: String? = null,
 ) : DockerClientException
+
+/**
+ * Thrown when listing containers fails.
+ */
+public expect class ContainerListingFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when streaming logs from a container fails.
+ */
+public expect class StreamingContainerLogsFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when streaming resource usage statistics for a container fails.
+ */
+public expect class StreamingContainerStatsFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when restarting a container fails.
+ */
+public expect class ContainerRestartFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when sending a signal to a container fails.
+ */
+public expect class ContainerKillFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when pausing a container fails.
+ */
+public expect class ContainerPauseFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when unpausing a container fails.
+ */
+public expect class ContainerUnpauseFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when downloading files or directories from a container fails.
+ */
+public expect class ContainerDownloadFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when connecting a container to a network fails.
+ */
+public expect class ContainerNetworkConnectionFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when disconnecting a container from a network fails.
+ */
+public expect class ContainerNetworkDisconnectionFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when waiting for a container to become healthy fails.
+ */
+public expect class ContainerHealthWaitFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when resizing the TTY attached to a container fails.
+ */
+public expect class ContainerTTYResizeFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when resizing the TTY attached to an exec instance fails.
+ */
+public expect class ExecTTYResizeFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when renaming a container fails.
+ */
+public expect class ContainerRenameFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when updating a container fails.
+ */
+public expect class ContainerUpdateFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when creating an image from a container fails.
+ */
+public expect class ContainerCommitFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when listing the filesystem changes in a container fails.
+ */
+public expect class ContainerFilesystemChangesRetrievalFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when listing the processes running in a container fails.
+ */
+public expect class ContainerProcessListingFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when exporting a container's filesystem fails.
+ */
+public expect class ContainerExportFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when removing stopped containers fails.
+ */
+public expect class ContainerPruneFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when inspecting an image fails.
+ */
+public expect class ImageInspectionFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when listing images fails.
+ */
+public expect class ImageListingFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when pushing an image fails.
+ */
+public expect class ImagePushFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when saving one or more images fails.
+ */
+public expect class ImageSaveFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
+
+/**
+ * Thrown when loading images fails.
+ */
+public expect class ImageLoadFailedException(
+    message: String,
+    cause: Throwable? = null,
+    golangErrorType: String? = null,
+) : DockerClientException
@@ -0,0 +1,70 @@
+/*
+    Copyright 2017-2022 Charles Korn.
+
+    Licensed under the Apache License, Version 2.0 (the "License");
+    you may not use this file except in compliance with the License.
+    You may obtain a copy of the License at
+
+        https://www.apache.org/licenses/LICENSE-2.0
+
+    Unless required by applicable law or agreed to in writing, software
+    distributed under the License is distributed on an "AS IS" BASIS,
+    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+    See the License for the specific language governing permissions and
+    limitations under the License.
+*/
+
+package batect.dockerclient
+
+import kotlinx.datetime.Instant
+
+/**
+ * Contains detailed information about an image.
+ *
+ * @see [DockerClient.inspectImage]
+ */
+public data class ImageInspectionResult(
+    val reference: ImageReference,
+    val repoTags: List<String>,
+    val repoDigests: List<String>,
+    val created: Instant,
+    val sizeInBytes: Long,
+    val os: String,
+    val architecture: String,
+    val variant: String?,
+    val author: String,
+    val config: ImageConfig,
+    val rootFS: ImageRootFS,
+)
+
+/**
+ * Contains the default configuration for containers created from an image.
+ *
+ * [exposedPorts] are in the format `port/protocol`, for example `8080/tcp`.
+ *
+ * @see [ImageInspectionResult]
+ * @see [DockerClient.inspectImage]
+ */
+public data class ImageConfig(
+    val environmentVariables: Map<String, String>,
+    val entrypoint: List<String>,
+    val command: List<String>,
+    val workingDirectory: String,
+    val user: String,
+    val exposedPorts: List<String>,
+    val volumes: List<String>,
+    val labels: Map<String, String>,
+    val healthcheck: ContainerHealthcheckConfig?,
+    val stopSignal: String?,
+)
+
+/**
+ * Contains information about the layers that make up an image's root filesystem.
+ *
+ * @see [ImageInspectionResult]
+ * @see [DockerClient.inspectImage]
+ */
+public data class ImageRootFS(
+    val type: String,
+    val layers: List<String>,
+)
@@ -0,0 +1,36 @@
+/*
+    Copyright 2017-2022 Charles Korn.
+
+    Licensed under the Apache License, Version 2.0 (the "License");
+    you may not use this file except in compliance with the License.
+    You may obtain a copy of the License at
+
+        https://www.apache.org/licenses/LICENSE-2.0
+
+    Unless required by applicable law or agreed to in writing, software
+    distributed under the License is distributed on an "AS IS" BASIS,
+    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+    See the License for the specific language governing permissions and
+    limitations under the License.
+*/
+
+package batect.dockerclient
+
+import kotlinx.datetime.Instant
+
+/**
+ * Contains summary information about an image.
+ *
+ * [parentID] is `null` if the image has no parent image on the local machine.
+ *
+ * @see [DockerClient.listImages]
+ */
+public data class ImageSummary(
+    val reference: ImageReference,
+    val parentID: String?,
+    val repoTags: List<String>,
+    val repoDigests: List<String>,
+    val sizeInBytes: Long,
+    val created: Instant,
+    val labels: Map<String, String>,
+)
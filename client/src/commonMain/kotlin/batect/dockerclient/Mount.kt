@@ -0,0 +1,88 @@
+/*
+    Copyright 2017-2022 Charles Korn.
+
+    Licensed under the Apache License, Version 2.0 (the "License");
+    you may not use this file except in compliance with the License.
+    You may obtain a copy of the License at
+
+        https://www.apache.org/licenses/LICENSE-2.0
+
+    Unless required by applicable law or agreed to in writing, software
+    distributed under the License is distributed on an "AS IS" BASIS,
+    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+    See the License for the specific language governing permissions and
+    limitations under the License.
+*/
+
+package batect.dockerclient
+
+import okio.Path
+
+/**
+ * A mount into a container, equivalent to the Docker CLI's `--mount` option.
+ *
+ * Unlike [HostMount], [VolumeMount] and [TmpfsMount], these mounts support the full set of options
+ * available for each type of mount.
+ *
+ * @see [BindTypeMount]
+ * @see [VolumeTypeMount]
+ * @see [TmpfsTypeMount]
+ * @see [ContainerCreationSpec.Builder.withMount]
+ */
+public sealed interface Mount {
+    public val containerPath: String
+    public val readOnly: Boolean
+
+    /**
+     * The consistency requirement for the mount: one of `consistent`, `cached`, `delegated` or `default`.
+     *
+     * This only has an effect on Docker Desktop for macOS.
+     */
+    public val consistency: String?
+}
+
+/**
+ * A local path mounted into a container.
+ *
+ * [propagation] is the bind propagation mode for the mount, for example `rprivate` or `rshared`.
+ */
+public data class BindTypeMount(
+    val localPath: Path,
+    override val containerPath: String,
+    override val readOnly: Boolean = false,
+    override val consistency: String? = null,
+    val propagation: String? = null,
+) : Mount
+
+/**
+ * A volume mounted into a container.
+ *
+ * If [volume] is `null`, a new anonymous volume is created for the container.
+ *
+ * [driver], [driverOptions] and [labels] are only used if the volume does not already exist and is created by this mount.
+ * Mounting a [subpath] of a volume requires Docker API version 1.45 or later.
+ */
+public data class VolumeTypeMount(
+    val volume: VolumeReference?,
+    override val containerPath: String,
+    override val readOnly: Boolean = false,
+    override val consistency: String? = null,
+    val noCopy: Boolean = false,
+    val subpath: String? = null,
+    val driver: String? = null,
+    val driverOptions: Map<String, String> = emptyMap(),
+    val labels: Map<String, String> = emptyMap(),
+) : Mount
+
+/**
+ * A tmpfs filesystem mounted into a container.
+ *
+ * [mode] is the file mode of the root of the filesystem, for example `"1777".toInt(8)`.
+ */
+public data class TmpfsTypeMount(
+    override val containerPath: String,
+    override val readOnly: Boolean = false,
+    override val consistency: String? = null,
+    val sizeInBytes: Long? = null,
+    val mode: Int? = null,
+) : Mount
@@ -0,0 +1,35 @@
+/*
+    Copyright 2017-2022 Charles Korn.
+
+    Licensed under the Apache License, Version 2.0 (the "License");
+    you may not use this file except in compliance with the License.
+    You may obtain a copy of the License at
+
+        https://www.apache.org/licenses/LICENSE-2.0
+
+    Unless required by applicable law or agreed to in writing, software
+    distributed under the License is distributed on an "AS IS" BASIS,
+    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+    See the License for the specific language governing permissions and
+    limitations under the License.
+*/
+
+package batect.dockerclient
+
+/**
+ * A container port and the host port it is published on.
+ *
+ * @see [ContainerSummary]
+ */
+public data class PortBinding(
+    val containerPort: Long,
+    val protocol: String,
+    /**
+     * The host interface the port is published on, or an empty string if the port is not published.
+     */
+    val hostIP: String,
+    /**
+     * The host port the port is published on, or 0 if the port is not published.
+     */
+    val hostPort: Long,
+)
@@ -0,0 +1,61 @@
+/*
+    Copyright 2017-2022 Charles Korn.
+
+    Licensed under the Apache License, Version 2.0 (the "License");
+    you may not use this file except in compliance with the License.
+    You may obtain a copy of the License at
+
+        https://www.apache.org/licenses/LICENSE-2.0
+
+    Unless required by applicable law or agreed to in writing, software
+    distributed under the License is distributed on an "AS IS" BASIS,
+    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+    See the License for the specific language governing permissions and
+    limitations under the License.
+*/
+
+package batect.dockerclient
+
+import okio.Path
+
+/**
+ * A file or directory on the host to be uploaded to a container.
+ *
+ * Unlike [UploadItem], the contents of the file or directory are streamed to the daemon rather than loaded into memory first.
+ *
+ * @see [DockerClient.uploadPathsToContainer]
+ */
+public data class UploadPath(
+    /**
+     * The file or directory on the host to upload.
+     */
+    val localPath: Path,
+    /**
+     * The path to upload [localPath] to, relative to the destination directory.
+     */
+    val containerPath: String,
+    /**
+     * Patterns for files and directories under [localPath] that should not be uploaded, in the same format as a `.dockerignore` file.
+     */
+    val excludePatterns: Set<String> = emptySet(),
+    /**
+     * The owner for all uploaded files and directories, or `null` to keep the owner from the host. Must be set if [group] is set.
+     */
+    val owner: Int? = null,
+    /**
+     * The group for all uploaded files and directories, or `null` to keep the group from the host. Must be set if [owner] is set.
+     */
+    val group: Int? = null,
+    /**
+     * The mode for all uploaded files, or `null` to keep the mode from the host.
+     */
+    val fileMode: Int? = null,
+    /**
+     * The mode for all uploaded directories, or `null` to keep the mode from the host.
+     */
+    val directoryMode: Int? = null,
+) {
+    init {
+        require((owner == null) == (group == null)) { "Owner and group must either both be set or both be null." }
+    }
+}
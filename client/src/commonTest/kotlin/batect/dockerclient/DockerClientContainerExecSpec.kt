@@ -496,6 +496,67 @@ class DockerClientContainerExecSpec : ShouldSpec({
             }
         }
 
+        should("be able to set the initial console size of an exec instance with a TTY attached") {
+            withRunningTestContainer { container ->
+                val spec = ContainerExecSpec.Builder(container)
+                    .withCommand("stty", "size")
+                    .withStdoutAttached()
+                    .withTTYAttached()
+                    .withInitialConsoleSize(40, 120)
+                    .build()
+
+                val exec = client.createExec(spec)
+                val stdout = Buffer()
+
+                client.startAndAttachToExec(exec, true, SinkTextOutput(stdout), null, null)
+
+                stdout.readUtf8() shouldBe "40 120\r\n"
+            }
+        }
+
+        should("be able to resize the TTY of a running exec instance") {
+            withRunningTestContainer { container ->
+                val spec = ContainerExecSpec.Builder(container)
+                    .withCommand("sh", "-c", "sleep 2; stty size > /tmp/console-size")
+                    .withTTYAttached()
+                    .build()
+
+                val exec = client.createExec(spec)
+                client.startExecDetached(exec)
+                client.resizeExecTTY(exec, 40, 120)
+
+                eventually(5.seconds, poll = 100.milliseconds) {
+                    client.inspectExec(exec).running shouldBe false
+                }
+
+                val checkSpec = ContainerExecSpec.Builder(container)
+                    .withCommand("cat", "/tmp/console-size")
+                    .withStdoutAttached()
+                    .build()
+
+                val checkExec = client.createExec(checkSpec)
+                val stdout = Buffer()
+
+                client.startAndAttachToExec(checkExec, false, SinkTextOutput(stdout), null, null)
+
+                stdout.readUtf8() shouldBe "40 120\n"
+            }
+        }
+
+        should("throw an appropriate exception when resizing the TTY of an exec instance to an invalid size") {
+            withRunningTestContainer { container ->
+                val spec = ContainerExecSpec.Builder(container)
+                    .withCommand("sh", "-c", "sleep 2")
+                    .withTTYAttached()
+                    .build()
+
+                val exec = client.createExec(spec)
+                val exception = shouldThrow<ExecTTYResizeFailedException> { client.resizeExecTTY(exec, 40, -1) }
+
+                exception.message shouldBe "console size -1x40 is invalid: height and width must not be negative"
+            }
+        }
+
         should("be able to run an exec instance without a TTY attached") {
             withRunningTestContainer { container ->
                 val spec = ContainerExecSpec.Builder(container)
@@ -27,6 +27,7 @@ import io.kotest.assertions.timing.eventually
 import io.kotest.common.ExperimentalKotest
 import io.kotest.core.spec.style.ShouldSpec
 import io.kotest.inspectors.forAll
+import io.kotest.matchers.collections.shouldContain
 import io.kotest.matchers.collections.shouldHaveAtLeastSize
 import io.kotest.matchers.collections.shouldHaveAtMostSize
 import io.kotest.matchers.comparables.shouldBeGreaterThan
@@ -49,6 +50,7 @@ import kotlinx.coroutines.sync.Semaphore
 import kotlinx.coroutines.withTimeout
 import kotlinx.datetime.Clock
 import okio.Buffer
+import okio.FileSystem
 import okio.Path
 import okio.Path.Companion.toPath
 import okio.Sink
@@ -192,6 +194,52 @@ class DockerClientContainerManagementSpec : ShouldSpec({
                 }
             }
 
+            should("be able to attach to a running container without replaying output it has already produced") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sh", "-c", "echo 'Before attaching' && sleep 5 && echo 'After attaching'")
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    val stdout = Buffer()
+
+                    client.startContainer(container)
+
+                    eventually(5.seconds, poll = 100.milliseconds) {
+                        val logs = Buffer()
+                        client.streamContainerLogs(container, SinkTextOutput(logs), null)
+
+                        logs.readUtf8() shouldBe "Before attaching\n"
+                    }
+
+                    client.attachToContainerIO(container, SinkTextOutput(stdout), null, null, includeHistoricalOutput = false)
+
+                    stdout.readUtf8() shouldBe "After attaching\n"
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("throw an appropriate exception when attaching to a container with invalid detach keys") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sh", "-c", "exit 0")
+                    .withTTY()
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    val exception = shouldThrow<AttachToContainerFailedException> {
+                        client.attachToContainerIO(container, SinkTextOutput(Buffer()), null, null, detachKeys = "not-a-key")
+                    }
+
+                    exception.message shouldContain "invalid detach keys 'not-a-key'"
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
             should("throw an appropriate exception when creating a container with an image that doesn't exist") {
                 val spec = ContainerCreationSpec.Builder(ImageReference("batect/this-image-does-not-exist:abc123"))
                     .withCommand("sh", "-c", "exit 123")
@@ -202,6 +250,47 @@ class DockerClientContainerManagementSpec : ShouldSpec({
                 exception.message shouldBe "Error response from daemon: No such image: batect/this-image-does-not-exist:abc123"
             }
 
+            should("throw an appropriate exception when creating a container with an invalid mount") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withMount(TmpfsTypeMount("/files", consistency = "bogus"))
+                    .build()
+
+                val exception = shouldThrow<ContainerCreationFailedException> { client.createContainer(spec) }
+
+                exception.message shouldBe "mount 0 is invalid: unknown consistency 'bogus'"
+            }
+
+            should("throw an appropriate exception when creating a container with an invalid seccomp profile") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withSeccompProfile("/path/to/profile.json")
+                    .build()
+
+                val exception = shouldThrow<ContainerCreationFailedException> { client.createContainer(spec) }
+
+                exception.message shouldBe "invalid container configuration: seccomp profile must be 'unconfined' or a JSON profile"
+            }
+
+            should("throw an appropriate exception when creating a container with an invalid platform") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withPlatform("linux/")
+                    .build()
+
+                val exception = shouldThrow<ContainerCreationFailedException> { client.createContainer(spec) }
+
+                exception.message shouldBe "\"\" is an invalid component of \"linux/\": platform specifier component must match \"^[A-Za-z0-9_-]+\$\": invalid argument"
+            }
+
+            should("throw an appropriate exception when creating a container with an invalid initial console size") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withTTY()
+                    .withInitialConsoleSize(40, -1)
+                    .build()
+
+                val exception = shouldThrow<ContainerCreationFailedException> { client.createContainer(spec) }
+
+                exception.message shouldBe "console size -1x40 is invalid: height and width must not be negative"
+            }
+
             should("throw an appropriate exception when creating a container with network aliases but no explicit network") {
                 val spec = ContainerCreationSpec.Builder(image)
                     .withNetworkAlias("some-alias")
@@ -536,33 +625,623 @@ class DockerClientContainerManagementSpec : ShouldSpec({
                         }
                     }
 
-                    val exitCode = exitCodeSource.await()
-                    val stdoutText = stdout.readUtf8()
-                    val stderrText = stderr.readUtf8()
+                    val exitCode = exitCodeSource.await()
+                    val stdoutText = stdout.readUtf8()
+                    val stderrText = stderr.readUtf8()
+
+                    stdoutText.lines() shouldHaveAtLeastSize 3
+                    stdoutText.lines() shouldHaveAtMostSize 6
+                    stderrText shouldBe ""
+                    exitCode shouldBe 0
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("be able to add labels to containers") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withLabels("first-key" to "first-value", "second-key" to "second-value")
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    val inspectionResult = client.inspectContainer(container)
+
+                    inspectionResult.config.labels shouldBe mapOf(
+                        "first-key" to "first-value",
+                        "second-key" to "second-value",
+                    )
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("be able to configure the restart policy, stop signal and stop timeout for a container") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withRestartPolicy("on-failure", maximumRetryCount = 3)
+                    .withStopSignal("SIGINT")
+                    .withStopTimeout(7.seconds)
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    val inspectionResult = client.inspectContainer(container)
+
+                    inspectionResult.hostConfig.restartPolicy shouldBe RestartPolicy("on-failure", 3)
+                    inspectionResult.config.stopSignal shouldBe "SIGINT"
+                    inspectionResult.config.stopTimeout shouldBe 7.seconds
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("use the default restart policy, stop signal and stop timeout for a container") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    val inspectionResult = client.inspectContainer(container)
+
+                    inspectionResult.hostConfig.restartPolicy shouldBe RestartPolicy("no", 0)
+                    inspectionResult.config.stopTimeout shouldBe null
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("be able to rename a container") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    client.renameContainer(container, "docker-client-renamed-container")
+
+                    val inspectionResult = client.inspectContainer(container)
+
+                    inspectionResult.name shouldBe "/docker-client-renamed-container"
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("throw an appropriate exception when renaming a container that doesn't exist") {
+                val exception = shouldThrow<ContainerRenameFailedException> { client.renameContainer(ContainerReference("does-not-exist"), "new-name") }
+
+                exception.message shouldContain "No such container: does-not-exist"
+            }
+
+            should("be able to update the restart policy and resource limits of a container") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withMemoryLimit(64L * 1024 * 1024)
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    val update = ContainerUpdateSpec.Builder()
+                        .withMemoryLimit(128L * 1024 * 1024)
+                        .withRestartPolicy("on-failure", maximumRetryCount = 2)
+                        .build()
+
+                    client.updateContainer(container, update)
+
+                    val inspectionResult = client.inspectContainer(container)
+
+                    inspectionResult.hostConfig.restartPolicy shouldBe RestartPolicy("on-failure", 2)
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("throw an appropriate exception when updating a container that doesn't exist") {
+                val update = ContainerUpdateSpec.Builder()
+                    .withRestartPolicy("always")
+                    .build()
+
+                val exception = shouldThrow<ContainerUpdateFailedException> { client.updateContainer(ContainerReference("does-not-exist"), update) }
+
+                exception.message shouldContain "No such container: does-not-exist"
+            }
+
+            should("be able to create an image from a container") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sh", "-c", "echo 'Hello from the committed image' > /committed.txt")
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    client.run(container, null, null, null)
+
+                    val commitSpec = ContainerCommitSpec.Builder(container)
+                        .withReference("batect-docker-client/commit-test:latest")
+                        .withChange("CMD [\"cat\", \"/committed.txt\"]")
+                        .withLabel("batect.dev.example", "committed")
+                        .build()
+
+                    val committedImage = client.commitContainer(commitSpec)
+
+                    try {
+                        val committedContainer = client.createContainer(ContainerCreationSpec.Builder(committedImage).build())
+
+                        try {
+                            val output = Buffer()
+                            client.run(committedContainer, SinkTextOutput(output), null, null)
+
+                            output.readUtf8() shouldBe "Hello from the committed image\n"
+                        } finally {
+                            client.removeContainer(committedContainer, force = true)
+                        }
+                    } finally {
+                        client.deleteImage(committedImage, force = true)
+                    }
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("throw an appropriate exception when creating an image from a container that doesn't exist") {
+                val exception = shouldThrow<ContainerCommitFailedException> { client.commitContainer(ContainerCommitSpec.Builder(ContainerReference("does-not-exist")).build()) }
+
+                exception.message shouldContain "No such container: does-not-exist"
+            }
+
+            should("be able to expose a port on a host IP with a port chosen by Docker and find the chosen port") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withExposedPort(ExposedPort(0, 80, localIP = "127.0.0.1"))
+                    .withCommand("sleep", "60")
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    client.startContainer(container)
+
+                    val ports = client.inspectContainer(container).networkSettings.ports
+                    ports.map { it.copy(hostPort = 0) } shouldBe listOf(PortBinding(80, "tcp", "127.0.0.1", 0))
+                    ports.single().hostPort shouldNotBe 0L
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("be able to expose a range of ports") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withExposedPort(ExposedPort(9100, 90, localPortRangeEnd = 9101, containerPortRangeEnd = 91))
+                    .withCommand("sleep", "60")
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    client.startContainer(container)
+
+                    val ports = client.inspectContainer(container).networkSettings.ports
+                    ports.filter { it.hostIP == "0.0.0.0" } shouldBe listOf(
+                        PortBinding(90, "tcp", "0.0.0.0", 9100),
+                        PortBinding(91, "tcp", "0.0.0.0", 9101),
+                    )
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("be able to inspect a container's state, configuration, network settings and mounts") {
+                val volume = client.createVolume("${DockerClientContainerManagementSpec::class.simpleName}-inspection-test-${Random.nextInt()}")
+
+                try {
+                    val spec = ContainerCreationSpec.Builder(image)
+                        .withEnvironmentVariable("SOME_VAR", "some=value")
+                        .withEntrypoint("sh", "-c")
+                        .withCommand("exit 3")
+                        .withWorkingDirectory("/work")
+                        .withUserAndGroup(123, 456)
+                        .withVolumeMount(volume, "/data", "ro")
+                        .build()
+
+                    val container = client.createContainer(spec)
+
+                    try {
+                        val beforeRunning = client.inspectContainer(container)
+
+                        beforeRunning.state.asClue {
+                            it.status shouldBe "created"
+                            it.running shouldBe false
+                            it.startedAt shouldBe null
+                            it.finishedAt shouldBe null
+                        }
+
+                        beforeRunning.config.asClue {
+                            it.image shouldBe image.id
+                            it.environmentVariables["SOME_VAR"] shouldBe "some=value"
+                            it.entrypoint shouldBe listOf("sh", "-c")
+                            it.command shouldBe listOf("exit 3")
+                            it.workingDirectory shouldBe "/work"
+                            it.user shouldBe "123:456"
+                        }
+
+                        beforeRunning.imageID shouldBe image.id
+                        beforeRunning.restartCount shouldBe 0
+                        beforeRunning.mounts.map { Triple(it.type, it.name, it.destination) } shouldBe listOf(Triple("volume", volume.name, "/data"))
+                        beforeRunning.mounts.single().readWrite shouldBe false
+
+                        client.run(container, null, null, null) shouldBe 3
+
+                        val afterRunning = client.inspectContainer(container)
+
+                        afterRunning.state.asClue {
+                            it.status shouldBe "exited"
+                            it.running shouldBe false
+                            it.exitCode shouldBe 3
+                            it.startedAt.shouldNotBeNull()
+                            it.finishedAt.shouldNotBeNull()
+                        }
+
+                        afterRunning.networkSettings.networks.map { it.networkName } shouldBe listOf("bridge")
+                    } finally {
+                        client.removeContainer(container, force = true)
+                    }
+                } finally {
+                    client.deleteVolume(volume)
+                }
+            }
+
+            context("waiting for a container to become healthy") {
+                val healthcheckImage = buildTestImage("healthcheck")
+
+                should("return when the container becomes healthy") {
+                    val spec = ContainerCreationSpec.Builder(healthcheckImage)
+                        .withCommand("sleep", "30")
+                        .build()
+
+                    val container = client.createContainer(spec)
+
+                    try {
+                        client.startContainer(container)
+
+                        client.waitForContainerToBeHealthy(container) shouldBe ContainerBecameHealthy
+                    } finally {
+                        client.removeContainer(container, force = true)
+                    }
+                }
+
+                should("return the last health check output when the container becomes unhealthy") {
+                    val spec = ContainerCreationSpec.Builder(healthcheckImage)
+                        .withCommand("sleep", "30")
+                        .withHealthcheckCommand("echo 'Not ready yet' && exit 1")
+                        .build()
+
+                    val container = client.createContainer(spec)
+
+                    try {
+                        client.startContainer(container)
+
+                        client.waitForContainerToBeHealthy(container) shouldBe ContainerBecameUnhealthy("Not ready yet\n")
+                    } finally {
+                        client.removeContainer(container, force = true)
+                    }
+                }
+
+                should("return the exit code when the container exits before becoming healthy") {
+                    val spec = ContainerCreationSpec.Builder(healthcheckImage)
+                        .withCommand("sh", "-c", "exit 4")
+                        .build()
+
+                    val container = client.createContainer(spec)
+
+                    try {
+                        client.startContainer(container)
+
+                        client.waitForContainerToBeHealthy(container) shouldBe ContainerExitedBeforeBecomingHealthy(4)
+                    } finally {
+                        client.removeContainer(container, force = true)
+                    }
+                }
+
+                should("throw an appropriate exception when the container does not have a health check") {
+                    val container = client.createContainer(ContainerCreationSpec.Builder(image).withCommand("sleep", "30").build())
+
+                    try {
+                        client.startContainer(container)
+
+                        val exception = shouldThrow<ContainerHealthWaitFailedException> { client.waitForContainerToBeHealthy(container) }
+                        exception.message shouldBe "container does not have a health check"
+                    } finally {
+                        client.removeContainer(container, force = true)
+                    }
+                }
+            }
+
+            should("automatically remove a container with auto-remove enabled after it exits") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sh", "-c", "exit 0")
+                    .withAutoRemove()
+                    .build()
+
+                // No need to clean up the container afterwards: Docker removes it once it exits.
+                val container = client.createContainer(spec)
+                client.startContainer(container)
+
+                eventually(5.seconds, poll = 100.milliseconds) {
+                    shouldThrow<ContainerInspectionFailedException> { client.inspectContainer(container) }
+                }
+            }
+
+            should("be able to run a container in another container's PID namespace") {
+                val firstContainer = client.createContainer(ContainerCreationSpec.Builder(image).withCommand("sleep", "30").build())
+
+                try {
+                    client.startContainer(firstContainer)
+
+                    val spec = ContainerCreationSpec.Builder(image)
+                        .withPIDMode("container:${firstContainer.id}")
+                        .withCommand("ps", "-o", "comm")
+                        .build()
+
+                    val secondContainer = client.createContainer(spec)
+
+                    try {
+                        val stdout = Buffer()
+                        client.run(secondContainer, SinkTextOutput(stdout), SinkTextOutput(Buffer()), null) shouldBe 0
+
+                        stdout.readUtf8() shouldContain "sleep"
+                    } finally {
+                        client.removeContainer(secondContainer, force = true)
+                    }
+                } finally {
+                    client.removeContainer(firstContainer, force = true)
+                }
+            }
+
+            should("be able to resize the TTY of a running container") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sh", "-c", "sleep 2; stty size")
+                    .withTTY()
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    client.startContainer(container)
+                    client.resizeContainerTTY(container, 40, 120)
+                    client.waitForContainerToExit(container)
+
+                    val stdout = Buffer()
+                    client.streamContainerLogs(container, SinkTextOutput(stdout), null)
+
+                    stdout.readUtf8() shouldBe "40 120\r\n"
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("throw an appropriate exception when resizing the TTY of a container to an invalid size") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sh", "-c", "sleep 60")
+                    .withTTY()
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    val exception = shouldThrow<ContainerTTYResizeFailedException> { client.resizeContainerTTY(container, -1, 80) }
+
+                    exception.message shouldBe "console size 80x-1 is invalid: height and width must not be negative"
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("throw an appropriate exception when resizing the TTY of a container that doesn't exist") {
+                val exception = shouldThrow<ContainerTTYResizeFailedException> { client.resizeContainerTTY(ContainerReference("does-not-exist"), 40, 120) }
+
+                exception.message shouldBe "Error response from daemon: No such container: does-not-exist"
+            }
+
+            should("be able to list containers, filtering by label") {
+                val label = "batect.dockerclient.test.list-containers"
+                val labelValue = Random.nextInt().toString()
+
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withName("list-containers-test-$labelValue")
+                    .withLabels(label to labelValue)
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    val runningContainers = client.listContainers(filters = mapOf("label" to setOf("$label=$labelValue")))
+                    runningContainers shouldBe emptyList()
+
+                    val allContainers = client.listContainers(all = true, filters = mapOf("label" to setOf("$label=$labelValue")))
+                    allContainers.map { it.reference } shouldBe listOf(container)
+
+                    val summary = allContainers.single()
+                    summary.names shouldBe listOf("/list-containers-test-$labelValue")
+                    summary.labels shouldBe mapOf(label to labelValue)
+                    summary.state shouldBe "created"
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("throw an appropriate exception when listing containers with an invalid filter") {
+                val exception = shouldThrow<ContainerListingFailedException> {
+                    client.listContainers(filters = mapOf("not-a-filter" to setOf("value")))
+                }
+
+                exception.message shouldContain "invalid filter 'not-a-filter'"
+            }
+
+            should("be able to remove stopped containers, filtering by label") {
+                val label = "batect.dockerclient.test.prune-containers"
+                val labelValue = Random.nextInt().toString()
+
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sh", "-c", "exit 0")
+                    .withLabels(label to labelValue)
+                    .build()
+
+                val stoppedContainer = client.createContainer(spec)
+                val otherContainer = client.createContainer(ContainerCreationSpec.Builder(image).withCommand("sh", "-c", "exit 0").build())
+
+                try {
+                    client.run(stoppedContainer, null, null, null)
+                    client.run(otherContainer, null, null, null)
+
+                    val result = client.pruneContainers(filters = mapOf("label" to setOf("$label=$labelValue")))
+
+                    result.containersRemoved shouldBe listOf(stoppedContainer)
+                    client.listContainers(all = true, filters = mapOf("label" to setOf("$label=$labelValue"))) shouldBe emptyList()
+                    client.listContainers(all = true, filters = mapOf("id" to setOf(otherContainer.id))).map { it.reference } shouldBe listOf(otherContainer)
+                } finally {
+                    // stoppedContainer is removed by pruneContainers, so only otherContainer needs to be cleaned up here.
+                    client.removeContainer(otherContainer, force = true)
+                }
+            }
+
+            should("throw an appropriate exception when removing stopped containers with an invalid filter") {
+                val exception = shouldThrow<ContainerPruneFailedException> {
+                    client.pruneContainers(filters = mapOf("not-a-filter" to setOf("value")))
+                }
+
+                exception.message shouldContain "invalid filter 'not-a-filter'"
+            }
+
+            should("be able to stream the logs of a container that has exited, limited to the last lines") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sh", "-c", "echo 'Line 1' && echo 'Line 2' && echo 'Line 3' && echo 'Error line' >/dev/stderr")
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    client.run(container, null, null, null)
+
+                    val stdout = Buffer()
+                    val stderr = Buffer()
+                    client.streamContainerLogs(container, SinkTextOutput(stdout), SinkTextOutput(stderr), tailLines = 2)
+
+                    stdout.readUtf8() shouldBe "Line 3\n"
+                    stderr.readUtf8() shouldBe "Error line\n"
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("throw an appropriate exception when streaming the logs of a container that doesn't exist") {
+                val exception = shouldThrow<StreamingContainerLogsFailedException> {
+                    client.streamContainerLogs(ContainerReference("does-not-exist"), SinkTextOutput(Buffer()), null)
+                }
+
+                exception.message shouldBe "Error response from daemon: No such container: does-not-exist"
+            }
+
+            should("be able to stream resource usage statistics from a running container") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sh", "-c", "sleep 9999")
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    client.startContainer(container)
+
+                    val samples = mutableListOf<ContainerStatsSample>()
+
+                    client.streamContainerStats(container) { sample ->
+                        samples.add(sample)
+
+                        if (samples.size < 2) ContainerStatsHandlerAction.ContinueStreaming else ContainerStatsHandlerAction.Stop
+                    }
+
+                    samples.size shouldBe 2
+                    samples.forAll { it.memoryLimitBytes shouldBeGreaterThan 0L }
+                    samples[1].timestamp shouldBeGreaterThan samples[0].timestamp
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("throw an appropriate exception when streaming resource usage statistics from a container that doesn't exist") {
+                val exception = shouldThrow<StreamingContainerStatsFailedException> {
+                    client.streamContainerStats(ContainerReference("does-not-exist")) { ContainerStatsHandlerAction.Stop }
+                }
+
+                exception.message shouldBe "Error response from daemon: No such container: does-not-exist"
+            }
+
+            should("be able to send a signal to a container") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sh", "-c", "trap 'exit 42' TERM; sleep 9999 & wait")
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    client.startContainer(container)
+
+                    val waitingForContainerToExit = ReadyNotification()
+                    val exitCodeSource = async { client.waitForContainerToExit(container, waitingForContainerToExit) }
+
+                    waitingForContainerToExit.waitForReady()
+                    client.killContainer(container, "SIGTERM")
+
+                    exitCodeSource.await() shouldBe 42
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("throw an appropriate exception when sending a signal to a container that doesn't exist") {
+                val exception = shouldThrow<ContainerKillFailedException> {
+                    client.killContainer(ContainerReference("does-not-exist"))
+                }
+
+                exception.message shouldBe "Error response from daemon: No such container: does-not-exist"
+            }
+
+            should("be able to pause, unpause and restart a container") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sh", "-c", "sleep 9999")
+                    .build()
+
+                val container = client.createContainer(spec)
 
-                    stdoutText.lines() shouldHaveAtLeastSize 3
-                    stdoutText.lines() shouldHaveAtMostSize 6
-                    stderrText shouldBe ""
-                    exitCode shouldBe 0
+                suspend fun currentState(): String = client.listContainers(all = true, filters = mapOf("id" to setOf(container.id))).single().state
+
+                try {
+                    client.startContainer(container)
+
+                    client.pauseContainer(container)
+                    currentState() shouldBe "paused"
+
+                    client.unpauseContainer(container)
+                    currentState() shouldBe "running"
+
+                    client.restartContainer(container, 1.seconds)
+                    currentState() shouldBe "running"
                 } finally {
                     client.removeContainer(container, force = true)
                 }
             }
 
-            should("be able to add labels to containers") {
-                val spec = ContainerCreationSpec.Builder(image)
-                    .withLabels("first-key" to "first-value", "second-key" to "second-value")
-                    .build()
-
+            should("throw an appropriate exception when pausing a container that isn't running") {
+                val spec = ContainerCreationSpec.Builder(image).build()
                 val container = client.createContainer(spec)
 
                 try {
-                    val inspectionResult = client.inspectContainer(container)
+                    val exception = shouldThrow<ContainerPauseFailedException> {
+                        client.pauseContainer(container)
+                    }
 
-                    inspectionResult.config.labels shouldBe mapOf(
-                        "first-key" to "first-value",
-                        "second-key" to "second-value",
-                    )
+                    exception.message shouldBe "Error response from daemon: Container ${container.id} is not running"
                 } finally {
                     client.removeContainer(container, force = true)
                 }
@@ -906,6 +1585,24 @@ class DockerClientContainerManagementSpec : ShouldSpec({
                     expectedErrorOutput = "touch: /files/some-other-file.txt: Read-only file system",
                     shouldExitWithZeroExitCode = false,
                 ),
+                TestScenario(
+                    "mount a tmpfs filesystem into a container with size and mode options",
+                    ContainerCreationSpec.Builder(image)
+                        .withMount(TmpfsTypeMount("/files", sizeInBytes = 1024L * 1024, mode = "1770".toInt(8)))
+                        .withCommand("sh", "-c", "stat -c '%a' /files && df -k /files | tail -n 1 | awk '{print \$2}'")
+                        .build(),
+                    "1770\n1024",
+                ),
+                TestScenario(
+                    "mount a read-only tmpfs filesystem into a container",
+                    ContainerCreationSpec.Builder(image)
+                        .withMount(TmpfsTypeMount("/files", readOnly = true))
+                        .withCommand("touch", "/files/some-file.txt")
+                        .build(),
+                    expectedOutput = "",
+                    expectedErrorOutput = "touch: /files/some-file.txt: Read-only file system",
+                    shouldExitWithZeroExitCode = false,
+                ),
                 TestScenario(
                     "mount a device into a container",
                     ContainerCreationSpec.Builder(image)
@@ -961,6 +1658,57 @@ class DockerClientContainerManagementSpec : ShouldSpec({
                         |shm                         20         0        20   0% /dev/shm
                     """.trimMargin(),
                 ),
+                TestScenario(
+                    "set a ulimit for a container",
+                    ContainerCreationSpec.Builder(image)
+                        .withCommand("sh", "-c", "ulimit -Sn && ulimit -Hn")
+                        .withUlimit("nofile", 1000, 2000)
+                        .build(),
+                    "1000\n2000",
+                ),
+                TestScenario(
+                    "set a memory limit for a container",
+                    ContainerCreationSpec.Builder(image)
+                        .withCommand("sh", "-c", "cat /sys/fs/cgroup/memory.max 2>/dev/null || cat /sys/fs/cgroup/memory/memory.limit_in_bytes")
+                        .withMemoryLimit(64L * 1024 * 1024)
+                        .build(),
+                    "67108864",
+                ),
+                TestScenario(
+                    "run a container with a read-only root filesystem",
+                    ContainerCreationSpec.Builder(image)
+                        .withReadOnlyRootFilesystem()
+                        .withCommand("touch", "/some-file.txt")
+                        .build(),
+                    expectedOutput = "",
+                    expectedErrorOutput = "touch: /some-file.txt: Read-only file system",
+                    shouldExitWithZeroExitCode = false,
+                ),
+                TestScenario(
+                    "run a container with the no new privileges option",
+                    ContainerCreationSpec.Builder(image)
+                        .withNoNewPrivileges()
+                        .withCommand("sh", "-c", "grep NoNewPrivs /proc/self/status")
+                        .build(),
+                    "NoNewPrivs:\t1",
+                ),
+                TestScenario(
+                    "set a sysctl for a container",
+                    ContainerCreationSpec.Builder(image)
+                        .withSysctl("net.ipv4.ip_forward", "1")
+                        .withCommand("cat", "/proc/sys/net/ipv4/ip_forward")
+                        .build(),
+                    "1",
+                ),
+                TestScenario(
+                    "add an additional group for a container's user",
+                    ContainerCreationSpec.Builder(image)
+                        .withUserAndGroup(123, 456)
+                        .withAdditionalGroup("789")
+                        .withCommand("id", "-G")
+                        .build(),
+                    "456 789",
+                ),
                 TestScenario(
                     "run without a TTY attached",
                     ContainerCreationSpec.Builder(image)
@@ -1007,6 +1755,16 @@ class DockerClientContainerManagementSpec : ShouldSpec({
                     expectedOutput = "Is a TTY\r\nThis is stdout\r\nThis is stderr",
                     expectedErrorOutput = "",
                 ),
+                TestScenario(
+                    "run with a TTY attached with an initial console size",
+                    ContainerCreationSpec.Builder(image)
+                        .withCommand("stty", "size")
+                        .withTTY()
+                        .withInitialConsoleSize(40, 120)
+                        .build(),
+                    expectedOutput = "40 120",
+                    expectedErrorOutput = "",
+                ),
                 TestScenario(
                     "run an unprivileged container",
                     ContainerCreationSpec.Builder(privilegesCheckImage)
@@ -1317,6 +2075,80 @@ class DockerClientContainerManagementSpec : ShouldSpec({
                 }
             }
 
+            should("be able to run a container attached to multiple networks") {
+                val firstNetwork = client.createNetwork("container-networking-test-${Random.nextInt()}", "bridge")
+                val secondNetwork = client.createNetwork("container-networking-test-${Random.nextInt()}", "bridge")
+
+                try {
+                    val spec = ContainerCreationSpec.Builder(image)
+                        .withNetwork(firstNetwork)
+                        .withNetworkAttachment(secondNetwork, setOf("the-second-alias"))
+                        .withCommand("sh", "-c", "ls /sys/class/net | grep -c eth && nslookup the-second-alias >/dev/null && echo 'alias resolved'")
+                        .build()
+
+                    val container = client.createContainer(spec)
+
+                    try {
+                        val stdout = Buffer()
+                        val stderr = Buffer()
+
+                        val exitCode = client.run(container, SinkTextOutput(stdout), SinkTextOutput(stderr), null)
+
+                        stdout.readUtf8().trim() shouldBe "2\nalias resolved"
+                        exitCode shouldBe 0
+                    } finally {
+                        client.removeContainer(container, force = true)
+                    }
+                } finally {
+                    client.deleteNetwork(firstNetwork)
+                    client.deleteNetwork(secondNetwork)
+                }
+            }
+
+            should("be able to connect a container to a network and disconnect it again") {
+                val network = client.createNetwork("container-networking-test-${Random.nextInt()}", "bridge")
+
+                try {
+                    val spec = ContainerCreationSpec.Builder(image)
+                        .withCommand("sh", "-c", "ls /sys/class/net | grep -c eth")
+                        .build()
+
+                    val container = client.createContainer(spec)
+
+                    try {
+                        client.connectContainerToNetwork(container, NetworkAttachment(network))
+
+                        val outputWhileConnected = Buffer()
+                        client.run(container, SinkTextOutput(outputWhileConnected), SinkTextOutput(Buffer()), null)
+                        outputWhileConnected.readUtf8().trim() shouldBe "2"
+
+                        client.disconnectContainerFromNetwork(container, network)
+
+                        val outputAfterDisconnecting = Buffer()
+                        client.run(container, SinkTextOutput(outputAfterDisconnecting), SinkTextOutput(Buffer()), null)
+                        outputAfterDisconnecting.readUtf8().trim() shouldBe "1"
+                    } finally {
+                        client.removeContainer(container, force = true)
+                    }
+                } finally {
+                    client.deleteNetwork(network)
+                }
+            }
+
+            should("throw an appropriate exception when connecting a container to a network that does not exist") {
+                val container = client.createContainer(ContainerCreationSpec.Builder(image).build())
+
+                try {
+                    val exception = shouldThrow<ContainerNetworkConnectionFailedException> {
+                        client.connectContainerToNetwork(container, NetworkAttachment(NetworkReference("this-network-does-not-exist")))
+                    }
+
+                    exception.message shouldContain "this-network-does-not-exist"
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
             should("be able to connect to a published port from a container with a corresponding EXPOSE instruction in the image") {
                 val httpServerImage = client.pullImage("nginx:1.21.6")
 
@@ -1685,6 +2517,55 @@ class DockerClientContainerManagementSpec : ShouldSpec({
                 }
             }
 
+            should("upload a directory from the host, applying ownership and mode overrides") {
+                val localDirectory = systemFileSystem.canonicalize("./src/commonTest/resources/container-mount-directory".toPath())
+                val expectedContents = systemFileSystem.read(localDirectory / "some-file.txt") { readUtf8() }
+
+                val spec = ContainerCreationSpec.Builder(uploadTargetImage)
+                    .withCommand("sh", "-c", "stat -c '%u:%g %a' /existing-directory/uploaded/some-file.txt && cat /existing-directory/uploaded/some-file.txt")
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    client.uploadPathsToContainer(
+                        container,
+                        setOf(UploadPath(localDirectory, "uploaded", owner = 1234, group = 5678, fileMode = "0640".toInt(8))),
+                        "/existing-directory",
+                    )
+
+                    val stdout = Buffer()
+                    val exitCode = client.run(container, SinkTextOutput(stdout), null, null)
+
+                    stdout.readUtf8() shouldBe "1234:5678 640\n$expectedContents"
+                    exitCode shouldBe 0
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("not upload files from the host that match an exclude pattern") {
+                val localDirectory = systemFileSystem.canonicalize("./src/commonTest/resources/container-mount-directory".toPath())
+
+                val spec = ContainerCreationSpec.Builder(uploadTargetImage)
+                    .withCommand("ls", "-A", "/existing-directory/uploaded")
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    client.uploadPathsToContainer(container, setOf(UploadPath(localDirectory, "uploaded", excludePatterns = setOf("*.txt"))), "/existing-directory")
+
+                    val stdout = Buffer()
+                    val exitCode = client.run(container, SinkTextOutput(stdout), null, null)
+
+                    stdout.readUtf8() shouldBe ""
+                    exitCode shouldBe 0
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
             should("throw an appropriate exception when attempting to upload a file to a non-existent target directory") {
                 val spec = ContainerCreationSpec.Builder(uploadTargetImage)
                     .build()
@@ -1728,6 +2609,166 @@ class DockerClientContainerManagementSpec : ShouldSpec({
             }
         }
 
+        context("downloading files and directories from a container") {
+            val spec = ContainerCreationSpec.Builder(image)
+                .withCommand("sh", "-c", "mkdir -p /output/reports && echo 'Hello from the container' > /output/reports/report.txt")
+                .build()
+
+            should("download a directory to a directory on the host") {
+                val container = client.createContainer(spec)
+                val destinationDirectory = FileSystem.SYSTEM_TEMPORARY_DIRECTORY / "docker-client-download-test-${Random.nextInt().toUInt()}"
+
+                try {
+                    client.run(container, null, null, null)
+
+                    systemFileSystem.createDirectory(destinationDirectory)
+                    client.downloadFromContainer(container, "/output/reports", destinationDirectory)
+
+                    systemFileSystem.read(destinationDirectory / "reports" / "report.txt") { readUtf8() } shouldBe "Hello from the container\n"
+                } finally {
+                    client.removeContainer(container, force = true)
+                    systemFileSystem.deleteRecursively(destinationDirectory)
+                }
+            }
+
+            should("download a file as a tar archive") {
+                val container = client.createContainer(spec)
+
+                try {
+                    client.run(container, null, null, null)
+
+                    val archive = Buffer()
+                    client.downloadArchiveFromContainer(container, "/output/reports/report.txt", SinkTextOutput(archive))
+
+                    val archiveContents = archive.readUtf8()
+                    archiveContents shouldContain "report.txt"
+                    archiveContents shouldContain "Hello from the container\n"
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("throw an appropriate exception when attempting to download a path that does not exist") {
+                val container = client.createContainer(spec)
+
+                try {
+                    val exception = shouldThrow<ContainerDownloadFailedException> {
+                        client.downloadArchiveFromContainer(container, "/does-not-exist", SinkTextOutput(Buffer()))
+                    }
+
+                    exception.message shouldBe "Error response from daemon: Could not find the file /does-not-exist in container ${container.id}"
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+        }
+
+        context("diagnosing a container") {
+            should("be able to list the filesystem changes made in a container") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sh", "-c", "mkdir -p /output && echo 'Hello from the container' > /output/report.txt")
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    client.run(container, null, null, null)
+
+                    val changes = client.listContainerFilesystemChanges(container)
+
+                    changes shouldContain ContainerFilesystemChange("/output", "added")
+                    changes shouldContain ContainerFilesystemChange("/output/report.txt", "added")
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("throw an appropriate exception when listing the filesystem changes of a container that doesn't exist") {
+                val exception = shouldThrow<ContainerFilesystemChangesRetrievalFailedException> { client.listContainerFilesystemChanges(ContainerReference("does-not-exist")) }
+
+                exception.message shouldContain "No such container: does-not-exist"
+            }
+
+            should("be able to list the processes running in a container") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sleep", "60")
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    client.startContainer(container)
+
+                    val processes = client.listContainerProcesses(container, "-o pid,comm")
+
+                    processes.titles shouldBe listOf("PID", "COMMAND")
+                    processes.processes.map { it.values[1] } shouldBe listOf("sleep")
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("throw an appropriate exception when listing the processes of a container that isn't running") {
+                val container = client.createContainer(ContainerCreationSpec.Builder(image).build())
+
+                try {
+                    val exception = shouldThrow<ContainerProcessListingFailedException> { client.listContainerProcesses(container) }
+
+                    exception.message shouldContain "is not running"
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("be able to export the filesystem of a container as a tar archive") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sh", "-c", "echo 'Hello from the container' > /report.txt")
+                    .build()
+
+                val container = client.createContainer(spec)
+
+                try {
+                    client.run(container, null, null, null)
+
+                    val archive = Buffer()
+                    client.exportContainer(container, SinkTextOutput(archive))
+
+                    val archiveContents = archive.readUtf8()
+                    archiveContents shouldContain "report.txt"
+                    archiveContents shouldContain "Hello from the container\n"
+                } finally {
+                    client.removeContainer(container, force = true)
+                }
+            }
+
+            should("be able to export the filesystem of a container to a file") {
+                val spec = ContainerCreationSpec.Builder(image)
+                    .withCommand("sh", "-c", "echo 'Hello from the container' > /report.txt")
+                    .build()
+
+                val container = client.createContainer(spec)
+                val destination = FileSystem.SYSTEM_TEMPORARY_DIRECTORY / "docker-client-export-test-${Random.nextInt().toUInt()}.tar"
+
+                try {
+                    client.run(container, null, null, null)
+                    client.exportContainerToFile(container, destination)
+
+                    val archiveContents = systemFileSystem.read(destination) { readUtf8() }
+                    archiveContents shouldContain "report.txt"
+                    archiveContents shouldContain "Hello from the container\n"
+                } finally {
+                    client.removeContainer(container, force = true)
+                    systemFileSystem.delete(destination)
+                }
+            }
+
+            should("throw an appropriate exception when exporting a container that doesn't exist") {
+                val exception = shouldThrow<ContainerExportFailedException> { client.exportContainer(ContainerReference("does-not-exist"), SinkTextOutput(Buffer())) }
+
+                exception.message shouldContain "No such container: does-not-exist"
+            }
+        }
+
         should("be able to run two containers in a row that use stdin") {
             repeat(2) {
                 val spec = ContainerCreationSpec.Builder(image)
@@ -16,6 +16,8 @@
 
 package batect.dockerclient
 
+import batect.dockerclient.io.SinkTextOutput
+import batect.dockerclient.io.SourceTextInput
 import io.kotest.assertions.throwables.shouldThrow
 import io.kotest.core.spec.style.ShouldSpec
 import io.kotest.inspectors.forAtLeastOne
@@ -27,8 +29,12 @@ import io.kotest.matchers.collections.shouldStartWith
 import io.kotest.matchers.comparables.shouldBeLessThan
 import io.kotest.matchers.shouldBe
 import io.kotest.matchers.shouldNotBe
+import io.kotest.matchers.string.shouldContain
 import kotlinx.coroutines.TimeoutCancellationException
 import kotlinx.coroutines.withTimeout
+import okio.Buffer
+import okio.FileSystem
+import kotlin.random.Random
 import kotlin.time.Duration.Companion.milliseconds
 import kotlin.time.ExperimentalTime
 import kotlin.time.measureTime
@@ -246,11 +252,133 @@ class DockerClientImagePullSpec : ShouldSpec({
         exception.message shouldBeIn expectedMessages
     }
 
+    should("be able to pull an image for a specific platform").onlyIfDockerDaemonSupportsLinuxContainers {
+        val image = "gcr.io/distroless/static:063a079c1a87bad3369cb9daf05e371e925c0c91"
+        client.deleteImageIfPresent(image)
+
+        val imageReferenceFromPull = client.pullImage(image, platform = "linux/arm64")
+        val imageReferenceFromGet = client.getImage(image)
+        imageReferenceFromPull shouldBe imageReferenceFromGet
+
+        client.deleteImage(imageReferenceFromPull, force = true)
+    }
+
+    should("fail when pulling an image with an invalid platform").onlyIfDockerDaemonPresent {
+        val exception = shouldThrow<ImagePullFailedException> {
+            client.pullImage(defaultLinuxTestImage, platform = "not/a/valid/platform")
+        }
+
+        exception.message shouldContain "not/a/valid/platform"
+    }
+
+    should("fail when pulling all tags of an image reference that includes a tag").onlyIfDockerDaemonPresent {
+        val exception = shouldThrow<ImagePullFailedException> {
+            client.pullAllImageTags("gcr.io/distroless/static:063a079c1a87bad3369cb9daf05e371e925c0c91")
+        }
+
+        exception.message shouldBe "a tag or digest cannot be used when pulling all tags of an image"
+    }
+
     should("return null when getting a non-existent image").onlyIfDockerDaemonPresent {
         val imageReference = client.getImage(imageThatDoesNotExist)
         imageReference shouldBe null
     }
 
+    should("be able to inspect an image").onlyIfDockerDaemonSupportsLinuxContainers {
+        val image = "gcr.io/distroless/static:063a079c1a87bad3369cb9daf05e371e925c0c91"
+        val imageReference = client.pullImage(image)
+
+        val inspectionResult = client.inspectImage(imageReference)
+
+        inspectionResult.reference shouldBe imageReference
+        inspectionResult.repoTags shouldContain image
+        inspectionResult.os shouldBe "linux"
+        inspectionResult.config.environmentVariables.keys shouldContain "PATH"
+        inspectionResult.rootFS.type shouldBe "layers"
+        inspectionResult.rootFS.layers shouldNotBe emptyList<String>()
+
+        client.inspectImage(image) shouldBe inspectionResult
+    }
+
+    should("fail when inspecting a non-existent image").onlyIfDockerDaemonPresent {
+        val exception = shouldThrow<ImageInspectionFailedException> {
+            client.inspectImage(imageThatDoesNotExist)
+        }
+
+        exception.message shouldBe "Error response from daemon: No such image: $imageThatDoesNotExist"
+    }
+
+    should("be able to list images, filtering by reference").onlyIfDockerDaemonSupportsLinuxContainers {
+        val image = "gcr.io/distroless/static:063a079c1a87bad3369cb9daf05e371e925c0c91"
+        val imageReference = client.pullImage(image)
+
+        val images = client.listImages(filters = mapOf("reference" to setOf(image)))
+
+        images.map { it.reference } shouldBe listOf(imageReference)
+
+        val summary = images.single()
+        summary.repoTags shouldContain image
+        summary.sizeInBytes shouldNotBe 0L
+    }
+
+    should("fail when listing images with an invalid filter").onlyIfDockerDaemonPresent {
+        val exception = shouldThrow<ImageListingFailedException> {
+            client.listImages(filters = mapOf("not-a-filter" to setOf("value")))
+        }
+
+        exception.message shouldContain "invalid filter 'not-a-filter'"
+    }
+
+    should("be able to save an image and load it again").onlyIfDockerDaemonSupportsLinuxContainers {
+        val image = "gcr.io/distroless/static:063a079c1a87bad3369cb9daf05e371e925c0c91"
+        val imageReference = client.pullImage(image)
+
+        val archive = Buffer()
+        client.saveImages(setOf(image), SinkTextOutput(archive))
+        client.deleteImage(imageReference, force = true)
+
+        client.loadImages(SourceTextInput(archive)) shouldBe listOf(image)
+        client.getImage(image) shouldBe imageReference
+    }
+
+    should("be able to save an image to a file and load it again").onlyIfDockerDaemonSupportsLinuxContainers {
+        val image = "gcr.io/distroless/static:063a079c1a87bad3369cb9daf05e371e925c0c91"
+        val imageReference = client.pullImage(image)
+        val archivePath = FileSystem.SYSTEM_TEMPORARY_DIRECTORY / "docker-client-image-save-test-${Random.nextInt().toUInt()}.tar"
+
+        try {
+            client.saveImagesToFile(setOf(image), archivePath)
+            client.deleteImage(imageReference, force = true)
+
+            client.loadImagesFromFile(archivePath) shouldBe listOf(image)
+            client.getImage(image) shouldBe imageReference
+        } finally {
+            systemFileSystem.delete(archivePath)
+        }
+    }
+
+    should("fail when saving a non-existent image").onlyIfDockerDaemonPresent {
+        shouldThrow<ImageSaveFailedException> {
+            client.saveImages(setOf(imageThatDoesNotExist), SinkTextOutput(Buffer()))
+        }
+    }
+
+    should("fail when loading images from something that is not an image archive").onlyIfDockerDaemonPresent {
+        shouldThrow<ImageLoadFailedException> {
+            client.loadImages(SourceTextInput(Buffer().writeUtf8("This is not an image archive")))
+        }
+    }
+
+    should("fail when loading images from a file that does not exist").onlyIfDockerDaemonPresent {
+        val archivePath = FileSystem.SYSTEM_TEMPORARY_DIRECTORY / "docker-client-image-load-test-${Random.nextInt().toUInt()}.tar"
+
+        val exception = shouldThrow<ImageLoadFailedException> {
+            client.loadImagesFromFile(archivePath)
+        }
+
+        exception.message shouldContain archivePath.toString()
+    }
+
     should("fail when deleting a non-existent image").onlyIfDockerDaemonPresent {
         val exception = shouldThrow<ImageDeletionFailedException> {
             client.deleteImage(ImageReference("this-image-does-not-exist"))
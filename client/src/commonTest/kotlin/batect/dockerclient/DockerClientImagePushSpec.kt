@@ -0,0 +1,91 @@
+/*
+    Copyright 2017-2022 Charles Korn.
+
+    Licensed under the Apache License, Version 2.0 (the "License");
+    you may not use this file except in compliance with the License.
+    You may obtain a copy of the License at
+
+        https://www.apache.org/licenses/LICENSE-2.0
+
+    Unless required by applicable law or agreed to in writing, software
+    distributed under the License is distributed on an "AS IS" BASIS,
+    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+    See the License for the specific language governing permissions and
+    limitations under the License.
+*/
+
+package batect.dockerclient
+
+import io.kotest.assertions.throwables.shouldThrow
+import io.kotest.assertions.timing.eventually
+import io.kotest.common.ExperimentalKotest
+import io.kotest.core.spec.style.ShouldSpec
+import io.kotest.matchers.collections.shouldNotBeEmpty
+import io.kotest.matchers.shouldBe
+import io.kotest.matchers.string.shouldContain
+import io.kotest.matchers.string.shouldStartWith
+import kotlin.time.Duration.Companion.milliseconds
+import kotlin.time.Duration.Companion.seconds
+
+@OptIn(ExperimentalKotest::class)
+class DockerClientImagePushSpec : ShouldSpec({
+    val client = closeAfterTest(DockerClient.create())
+
+    should("be able to push an image to a registry").onlyIfDockerDaemonSupportsLinuxContainers {
+        val registryImage = client.pullImage("registry:2.8.1")
+        val baseImage = client.pullImage("alpine:3.15.0")
+
+        val registrySpec = ContainerCreationSpec.Builder(registryImage)
+            .withExposedPort(ExposedPort(0, 5000, localIP = "127.0.0.1"))
+            .build()
+
+        val registry = client.createContainer(registrySpec)
+
+        try {
+            client.startContainer(registry)
+
+            val registryPort = client.inspectContainer(registry).networkSettings.ports.single().hostPort
+            val imageName = "localhost:$registryPort/batect-docker-client/push-test:latest"
+            val sourceContainer = client.createContainer(ContainerCreationSpec.Builder(baseImage).build())
+
+            try {
+                val imageToPush = client.commitContainer(ContainerCommitSpec.Builder(sourceContainer).withReference(imageName).build())
+
+                try {
+                    val progressUpdatesReceived = mutableListOf<ImagePullProgressUpdate>()
+
+                    // The registry may take a moment to start accepting connections after the container starts.
+                    val digest = eventually(10.seconds, poll = 500.milliseconds) {
+                        client.pushImage(imageName) { update -> progressUpdatesReceived.add(update) }
+                    }
+
+                    digest shouldStartWith "sha256:"
+                    progressUpdatesReceived.shouldNotBeEmpty()
+                    client.inspectImage(imageToPush).repoDigests shouldBe listOf("localhost:$registryPort/batect-docker-client/push-test@$digest")
+                } finally {
+                    client.deleteImage(imageToPush, force = true)
+                }
+            } finally {
+                client.removeContainer(sourceContainer, force = true)
+            }
+        } finally {
+            client.removeContainer(registry, force = true)
+        }
+    }
+
+    should("fail when pushing an image that does not exist on the local machine").onlyIfDockerDaemonPresent {
+        val exception = shouldThrow<ImagePushFailedException> {
+            client.pushImage("batect/this-image-does-not-exist:abc123")
+        }
+
+        exception.message shouldContain "batect/this-image-does-not-exist"
+    }
+
+    should("fail when pushing an image with an invalid reference").onlyIfDockerDaemonPresent {
+        val exception = shouldThrow<ImagePushFailedException> {
+            client.pushImage("Not A Valid Reference")
+        }
+
+        exception.message shouldContain "invalid reference format"
+    }
+})
@@ -29,20 +29,32 @@ import batect.dockerclient.native.BuildImageProgressUpdate_StepPullProgressUpdat
 import batect.dockerclient.native.BuildImageProgressUpdate_StepStarting
 import batect.dockerclient.native.BuildImageRequest
 import batect.dockerclient.native.ClientConfiguration
+import batect.dockerclient.native.CommitContainerRequest
 import batect.dockerclient.native.CreateContainerRequest
 import batect.dockerclient.native.CreateExecRequest
+import batect.dockerclient.native.ListContainersRequest
+import batect.dockerclient.native.ListImagesRequest
+import batect.dockerclient.native.PruneContainersRequest
+import batect.dockerclient.native.RestartPolicy
+import batect.dockerclient.native.SaveImagesRequest
+import batect.dockerclient.native.StreamContainerLogsRequest
 import batect.dockerclient.native.StreamEventsRequest
 import batect.dockerclient.native.StringPair
 import batect.dockerclient.native.StringToStringListPair
 import batect.dockerclient.native.TLSConfiguration
+import batect.dockerclient.native.UpdateContainerRequest
+import batect.dockerclient.native.UploadPathsToContainerRequest
 import batect.dockerclient.native.UploadToContainerRequest
+import batect.dockerclient.native.aliases
 import batect.dockerclient.native.attributes
 import batect.dockerclient.native.bindMounts
 import batect.dockerclient.native.buildArgs
 import batect.dockerclient.native.capabilitiesToAdd
 import batect.dockerclient.native.capabilitiesToDrop
+import batect.dockerclient.native.changes
 import batect.dockerclient.native.command
 import batect.dockerclient.native.config
+import batect.dockerclient.native.containerIDs
 import batect.dockerclient.native.deviceMounts
 import batect.dockerclient.native.directories
 import batect.dockerclient.native.entrypoint
@@ -53,22 +65,39 @@ import batect.dockerclient.native.extraHosts
 import batect.dockerclient.native.fileSecrets
 import batect.dockerclient.native.files
 import batect.dockerclient.native.filters
+import batect.dockerclient.native.groupAdd
 import batect.dockerclient.native.healthcheckCommand
 import batect.dockerclient.native.imageTags
 import batect.dockerclient.native.labels
 import batect.dockerclient.native.log
 import batect.dockerclient.native.loggingOptions
+import batect.dockerclient.native.mounts
+import batect.dockerclient.native.names
 import batect.dockerclient.native.nativeAPI
 import batect.dockerclient.native.networkAliases
+import batect.dockerclient.native.networks
+import batect.dockerclient.native.paths
+import batect.dockerclient.native.ports
+import batect.dockerclient.native.processes
+import batect.dockerclient.native.references
+import batect.dockerclient.native.repoDigests
+import batect.dockerclient.native.repoTags
+import batect.dockerclient.native.rootFSLayers
+import batect.dockerclient.native.seLinuxLabels
 import batect.dockerclient.native.sshAgents
+import batect.dockerclient.native.sysctls
 import batect.dockerclient.native.test
+import batect.dockerclient.native.titles
 import batect.dockerclient.native.tmpfsMounts
+import batect.dockerclient.native.ulimits
 import batect.dockerclient.native.values
+import batect.dockerclient.native.volumes
 import jnr.ffi.Runtime
 import jnr.ffi.Struct
 import kotlinx.datetime.Instant
 import okio.Path.Companion.toPath
 import kotlin.time.Duration.Companion.nanoseconds
+import kotlin.time.Duration.Companion.seconds
 
 internal fun DockerClientConfiguration(native: ClientConfiguration): DockerClientConfiguration {
     val configDirectory = native.configDirectoryPath.get()
@@ -231,10 +260,94 @@ internal fun CreateContainerRequest(jvm: ContainerCreationSpec): CreateContainer
     request.attachStdin.set(jvm.attachStdin)
     request.stdinOnce.set(jvm.stdinOnce)
     request.openStdin.set(jvm.openStdin)
+    request.memoryLimitInBytes.set(jvm.memoryLimitInBytes ?: 0)
+    request.memoryReservationInBytes.set(jvm.memoryReservationInBytes ?: 0)
+    request.memorySwapLimitInBytes.set(jvm.memorySwapLimitInBytes ?: 0)
+    request.cpuCount.set(jvm.cpuCount ?: 0.0)
+    request.cpuQuota.set(jvm.cpuQuotaInMicroseconds ?: 0)
+    request.cpuPeriod.set(jvm.cpuPeriodInMicroseconds ?: 0)
+    request.cpuShares.set(jvm.cpuShares ?: 0)
+    request.cpuSetCPUs.set(jvm.cpuSetCPUs)
+    request.cpuSetMemoryNodes.set(jvm.cpuSetMemoryNodes)
+    request.pidsLimit.set(jvm.pidsLimit ?: 0)
+    request.ulimits = jvm.ulimits
+    request.blockIOWeight.set(jvm.blockIOWeight?.toLong() ?: 0)
+    request.mounts = jvm.mounts
+    request.readOnlyRootFilesystem.set(jvm.readOnlyRootFilesystem)
+    request.noNewPrivileges.set(jvm.noNewPrivileges)
+    request.seccompProfile.set(jvm.seccompProfile)
+    request.appArmorProfile.set(jvm.appArmorProfile)
+    request.seLinuxLabels = jvm.seLinuxLabels
+    request.sysctls = jvm.sysctls.map { StringPair(it.key, it.value) }
+    request.usernsMode.set(jvm.usernsMode)
+    request.groupAdd = jvm.additionalGroups
+    request.oomScoreAdjustment.set(jvm.oomScoreAdjustment?.toLong() ?: 0)
+
+    if (jvm.restartPolicy != null) {
+        request.restartPolicyPointer.set(Struct.getMemory(RestartPolicy(jvm.restartPolicy)))
+    } else {
+        request.restartPolicyPointer.set(0)
+    }
+
+    request.stopSignal.set(jvm.stopSignal)
+    request.haveStopTimeout.set(jvm.stopTimeout != null)
+    request.stopTimeoutSeconds.set(jvm.stopTimeout?.inWholeSeconds ?: 0)
+    request.networks = jvm.networkAttachments
+    request.autoRemove.set(jvm.autoRemove)
+    request.platform.set(jvm.platform)
+    request.ipcMode.set(jvm.ipcMode)
+    request.pidMode.set(jvm.pidMode)
+    request.utsMode.set(jvm.utsMode)
+    request.initialConsoleHeight.set(jvm.initialConsoleHeight ?: 0)
+    request.initialConsoleWidth.set(jvm.initialConsoleWidth ?: 0)
 
     return request
 }
 
+internal fun UpdateContainerRequest(jvm: ContainerUpdateSpec): UpdateContainerRequest {
+    val request = UpdateContainerRequest(Runtime.getRuntime(nativeAPI))
+    request.memoryLimitInBytes.set(jvm.memoryLimitInBytes ?: 0)
+    request.memoryReservationInBytes.set(jvm.memoryReservationInBytes ?: 0)
+    request.memorySwapLimitInBytes.set(jvm.memorySwapLimitInBytes ?: 0)
+    request.cpuCount.set(jvm.cpuCount ?: 0.0)
+    request.cpuQuota.set(jvm.cpuQuotaInMicroseconds ?: 0)
+    request.cpuPeriod.set(jvm.cpuPeriodInMicroseconds ?: 0)
+    request.cpuShares.set(jvm.cpuShares ?: 0)
+    request.cpuSetCPUs.set(jvm.cpuSetCPUs)
+    request.cpuSetMemoryNodes.set(jvm.cpuSetMemoryNodes)
+    request.pidsLimit.set(jvm.pidsLimit ?: 0)
+    request.blockIOWeight.set(jvm.blockIOWeight?.toLong() ?: 0)
+
+    if (jvm.restartPolicy != null) {
+        request.restartPolicyPointer.set(Struct.getMemory(RestartPolicy(jvm.restartPolicy)))
+    } else {
+        request.restartPolicyPointer.set(0)
+    }
+
+    return request
+}
+
+internal fun CommitContainerRequest(jvm: ContainerCommitSpec): CommitContainerRequest {
+    val request = CommitContainerRequest(Runtime.getRuntime(nativeAPI))
+    request.containerID.set(jvm.container.id)
+    request.reference.set(jvm.reference)
+    request.author.set(jvm.author)
+    request.message.set(jvm.message)
+    request.changes = jvm.changes
+    request.labels = jvm.labels.map { StringPair(it.key, it.value) }
+    request.pause.set(jvm.pause)
+
+    return request
+}
+
+internal fun RestartPolicy(jvm: batect.dockerclient.RestartPolicy): RestartPolicy {
+    val policy = RestartPolicy(Runtime.getRuntime(nativeAPI))
+    policy.name.set(jvm.name)
+    policy.maximumRetryCount.set(jvm.maximumRetryCount)
+
+    return policy
+}
+
 internal fun ContainerInspectionResult(native: batect.dockerclient.native.ContainerInspectionResult) =
     ContainerInspectionResult(
         ContainerReference(native.id.get()),
@@ -242,10 +355,65 @@ internal fun ContainerInspectionResult(native: batect.dockerclient.native.Contai
         ContainerHostConfig(native.hostConfig!!),
         ContainerState(native.state!!),
         ContainerConfig(native.config!!),
+        ContainerNetworkSettings(native.networkSettings!!),
+        Instant.fromEpochMilliseconds(native.created.get()),
+        native.imageID.get(),
+        native.restartCount.get(),
+        native.mounts.map { ContainerMountPoint(it) },
+    )
+
+internal fun ContainerNetworkSettings(native: batect.dockerclient.native.ContainerNetworkSettings): ContainerNetworkSettings =
+    ContainerNetworkSettings(
+        native.ports.map { PortBinding(it) },
+        native.networks.map { ContainerNetworkEndpoint(it) },
+    )
+
+internal fun ContainerNetworkEndpoint(native: batect.dockerclient.native.ContainerNetworkEndpoint): ContainerNetworkEndpoint =
+    ContainerNetworkEndpoint(
+        native.networkName.get(),
+        native.networkID.get(),
+        native.endpointID.get(),
+        native.aliases,
+        native.ipAddress.get(),
+        native.ipPrefixLength.get(),
+        native.gateway.get(),
+        native.globalIPv6Address.get(),
+        native.globalIPv6PrefixLength.get(),
+        native.iPv6Gateway.get(),
+        native.macAddress.get(),
+    )
+
+internal fun ContainerMountPoint(native: batect.dockerclient.native.ContainerMountPoint): ContainerMountPoint =
+    ContainerMountPoint(
+        native.type.get(),
+        native.name.get(),
+        native.source.get(),
+        native.destination.get(),
+        native.driver.get(),
+        native.mode.get(),
+        native.readWrite.get(),
+        native.propagation.get(),
     )
 
 internal fun ContainerHostConfig(native: batect.dockerclient.native.ContainerHostConfig): ContainerHostConfig =
-    ContainerHostConfig(ContainerLogConfig(native.logConfig!!))
+    ContainerHostConfig(
+        ContainerLogConfig(native.logConfig!!),
+        RestartPolicy(native.restartPolicy!!),
+    )
+
+internal fun RestartPolicy(native: RestartPolicy): batect.dockerclient.RestartPolicy =
+    batect.dockerclient.RestartPolicy(native.name.get(), native.maximumRetryCount.get())
+
+internal fun ContainerFilesystemChange(native: batect.dockerclient.native.ContainerFilesystemChange): ContainerFilesystemChange =
+    ContainerFilesystemChange(native.path.get(), native.kind.get())
+
+internal fun ContainerProcessList(native: batect.dockerclient.native.ContainerTopResult): ContainerProcessList =
+    ContainerProcessList(
+        native.titles,
+        native.processes.map { ContainerProcess(it) },
+    )
+
+internal fun ContainerProcess(native: batect.dockerclient.native.ContainerProcess): ContainerProcess = ContainerProcess(native.values)
 
 internal fun ContainerLogConfig(native: batect.dockerclient.native.ContainerLogConfig): ContainerLogConfig =
     ContainerLogConfig(
@@ -256,8 +424,22 @@ internal fun ContainerLogConfig(native: batect.dockerclient.native.ContainerLogC
 internal fun ContainerState(native: batect.dockerclient.native.ContainerState): ContainerState =
     ContainerState(
         if (native.health == null) null else ContainerHealthState(native.health!!),
+        native.status.get(),
+        native.running.get(),
+        native.paused.get(),
+        native.restarting.get(),
+        native.oomKilled.get(),
+        native.dead.get(),
+        native.pid.get(),
+        native.exitCode.get(),
+        native.error.get(),
+        instantOrNull(native.startedAt.get()),
+        instantOrNull(native.finishedAt.get()),
     )
 
+private fun instantOrNull(epochMilliseconds: Long): Instant? =
+    if (epochMilliseconds == 0L) null else Instant.fromEpochMilliseconds(epochMilliseconds)
+
 internal fun ContainerHealthState(native: batect.dockerclient.native.ContainerHealthState): ContainerHealthState =
     ContainerHealthState(
         native.status.get(),
@@ -276,6 +458,14 @@ internal fun ContainerConfig(native: batect.dockerclient.native.ContainerConfig)
     ContainerConfig(
         native.labels.associate { it.key.get() to it.value.get() },
         if (native.healthcheck == null) null else ContainerHealthcheckConfig(native.healthcheck!!),
+        native.stopSignal.get().ifEmpty { null },
+        if (native.haveStopTimeout.get()) native.stopTimeoutSeconds.get().seconds else null,
+        native.image.get(),
+        environmentVariablesToMap(native.environmentVariables),
+        native.command,
+        native.entrypoint,
+        native.workingDirectory.get(),
+        native.user.get(),
     )
 
 internal fun ContainerHealthcheckConfig(native: batect.dockerclient.native.ContainerHealthcheckConfig): ContainerHealthcheckConfig =
@@ -287,6 +477,60 @@ internal fun ContainerHealthcheckConfig(native: batect.dockerclient.native.Conta
         native.retries.intValue(),
     )
 
+internal fun ImageInspectionResult(native: batect.dockerclient.native.ImageInspectionResult): ImageInspectionResult =
+    ImageInspectionResult(
+        ImageReference(native.id.get()),
+        native.repoTags,
+        native.repoDigests,
+        Instant.fromEpochMilliseconds(native.created.get()),
+        native.sizeInBytes.get(),
+        native.os.get(),
+        native.architecture.get(),
+        native.variant.get().ifEmpty { null },
+        native.author.get(),
+        ImageConfig(native.config!!),
+        ImageRootFS(native.rootFSType.get(), native.rootFSLayers),
+    )
+
+internal fun ImageConfig(native: batect.dockerclient.native.ImageConfig): ImageConfig =
+    ImageConfig(
+        environmentVariablesToMap(native.environmentVariables),
+        native.entrypoint,
+        native.command,
+        native.workingDirectory.get(),
+        native.user.get(),
+        native.exposedPorts,
+        native.volumes,
+        native.labels.associate { it.key.get() to it.value.get() },
+        if (native.healthcheck == null) null else ContainerHealthcheckConfig(native.healthcheck!!),
+        native.stopSignal.get().ifEmpty { null },
+    )
+
+internal fun ListImagesRequest(all: Boolean, filters: Map<String, Set<String>>): ListImagesRequest {
+    val request = ListImagesRequest(Runtime.getRuntime(nativeAPI))
+    request.all.set(all)
+    request.filters = filters.map { StringToStringListPair(it.key, it.value) }
+
+    return request
+}
+
+internal fun SaveImagesRequest(names: Set<String>): SaveImagesRequest {
+    val request = SaveImagesRequest(Runtime.getRuntime(nativeAPI))
+    request.references = names.toList()
+
+    return request
+}
+
+internal fun ImageSummary(native: batect.dockerclient.native.ImageSummary): ImageSummary = ImageSummary(
+    ImageReference(native.id.get()),
+    native.parentID.get().ifEmpty { null },
+    native.repoTags,
+    native.repoDigests,
+    native.sizeInBytes.get(),
+    Instant.fromEpochMilliseconds(native.created.get()),
+    native.labels.associate { it.key.get() to it.value.get() },
+)
+
 internal fun UploadToContainerRequest(items: Set<UploadItem>): UploadToContainerRequest {
     val directories = items.filterIsInstance<UploadDirectory>()
     val files = items.filterIsInstance<UploadFile>()
@@ -297,6 +541,14 @@ internal fun UploadToContainerRequest(items: Set<UploadItem>): UploadToContainer
     return request
 }
 
+internal fun UploadPathsToContainerRequest(paths: Set<UploadPath>, followSymlinks: Boolean): UploadPathsToContainerRequest {
+    val request = UploadPathsToContainerRequest(Runtime.getRuntime(nativeAPI))
+    request.paths = paths
+    request.followSymlinks.set(followSymlinks)
+
+    return request
+}
+
 internal fun Event(native: batect.dockerclient.native.Event): Event = Event(
     native.type.get(),
     native.action.get(),
@@ -323,6 +575,82 @@ internal fun StreamEventsRequest(since: Instant?, until: Instant?, filters: Map<
     return request
 }
 
+internal fun StreamContainerLogsRequest(
+    container: ContainerReference,
+    follow: Boolean,
+    showTimestamps: Boolean,
+    tailLines: Long?,
+    since: Instant?,
+    until: Instant?,
+): StreamContainerLogsRequest {
+    val request = StreamContainerLogsRequest(Runtime.getRuntime(nativeAPI))
+    request.containerID.set(container.id)
+    request.follow.set(follow)
+    request.showTimestamps.set(showTimestamps)
+    request.haveTailLimit.set(tailLines != null)
+    request.tailLines.set(tailLines ?: 0)
+    request.haveSinceFilter.set(since != null)
+    request.sinceSeconds.set(since?.epochSeconds ?: 0)
+    request.sinceNanoseconds.set(since?.nanosecondsOfSecond?.toLong() ?: 0)
+    request.haveUntilFilter.set(until != null)
+    request.untilSeconds.set(until?.epochSeconds ?: 0)
+    request.untilNanoseconds.set(until?.nanosecondsOfSecond?.toLong() ?: 0)
+
+    return request
+}
+
+internal fun ContainerStatsSample(native: batect.dockerclient.native.ContainerStatsSample): ContainerStatsSample = ContainerStatsSample(
+    Instant.fromEpochMilliseconds(native.timestamp.get()),
+    native.cpuPercentage.get(),
+    native.memoryUsageBytes.get(),
+    native.memoryLimitBytes.get(),
+    native.networkReceivedBytes.get(),
+    native.networkTransmittedBytes.get(),
+    native.networkReceivedBytesDelta.get(),
+    native.networkTransmittedBytesDelta.get(),
+    native.blockIOReadBytes.get(),
+    native.blockIOWrittenBytes.get(),
+    native.blockIOReadBytesDelta.get(),
+    native.blockIOWrittenBytesDelta.get(),
+)
+
+internal fun ListContainersRequest(all: Boolean, filters: Map<String, Set<String>>): ListContainersRequest {
+    val request = ListContainersRequest(Runtime.getRuntime(nativeAPI))
+    request.all.set(all)
+    request.filters = filters.map { StringToStringListPair(it.key, it.value) }
+
+    return request
+}
+
+internal fun PruneContainersRequest(filters: Map<String, Set<String>>): PruneContainersRequest {
+    val request = PruneContainersRequest(Runtime.getRuntime(nativeAPI))
+    request.filters = filters.map { StringToStringListPair(it.key, it.value) }
+
+    return request
+}
+
+internal fun ContainerPruneResult(native: batect.dockerclient.native.PruneContainersResult): ContainerPruneResult = ContainerPruneResult(
+    native.containerIDs.map { ContainerReference(it) },
+    native.spaceReclaimedInBytes.get(),
+)
+
+internal fun ContainerSummary(native: batect.dockerclient.native.ContainerSummary): ContainerSummary = ContainerSummary(
+    ContainerReference(native.id.get()),
+    native.names,
+    native.image.get(),
+    native.labels.associate { it.key.get() to it.value.get() },
+    native.state.get(),
+    native.status.get(),
+    native.ports.map { PortBinding(it) },
+)
+
+internal fun PortBinding(native: batect.dockerclient.native.PortBinding): PortBinding = PortBinding(
+    native.containerPort.get(),
+    native.protocol.get(),
+    native.hostIP.get(),
+    native.hostPort.get(),
+)
+
 internal fun CreateExecRequest(jvm: ContainerExecSpec): CreateExecRequest {
     val request = CreateExecRequest(Runtime.getRuntime(nativeAPI))
     request.containerID.set(jvm.container.id)
@@ -335,6 +663,8 @@ internal fun CreateExecRequest(jvm: ContainerExecSpec): CreateExecRequest {
     request.workingDirectory.set(jvm.workingDirectory)
     request.user.set(jvm.userAndGroupFormattedForDocker)
     request.privileged.set(jvm.privileged)
+    request.initialConsoleHeight.set(jvm.initialConsoleHeight ?: 0)
+    request.initialConsoleWidth.set(jvm.initialConsoleWidth ?: 0)
 
     return request
 }
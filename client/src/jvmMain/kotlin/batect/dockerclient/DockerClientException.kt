@@ -228,5 +228,205 @@ public actual class StartingContainerExecFailedException actual constructor(
     internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
 }
 
+public actual class ContainerListingFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class StreamingContainerLogsFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class StreamingContainerStatsFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerRestartFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerKillFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerPauseFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerUnpauseFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerDownloadFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerNetworkConnectionFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerNetworkDisconnectionFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerHealthWaitFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerTTYResizeFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ExecTTYResizeFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerRenameFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerUpdateFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerCommitFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerFilesystemChangesRetrievalFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerProcessListingFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerExportFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ContainerPruneFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ImageInspectionFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ImageListingFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ImagePushFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ImageSaveFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
+public actual class ImageLoadFailedException actual constructor(
+    message: String,
+    cause: Throwable?,
+    golangErrorType: String?,
+) : DockerClientException(message, cause, golangErrorType) {
+    internal constructor(error: Error) : this(error.cleanErrorMessage, null, golangErrorType = error.type.get())
+}
+
 private val Error.cleanErrorMessage: String
     get() = this.message.get().removePrefix("Error: ")
@@ -20,12 +20,20 @@ import batect.dockerclient.io.TextInput
 import batect.dockerclient.io.TextOutput
 import batect.dockerclient.native.BuildImageProgressCallback
 import batect.dockerclient.native.BuildImageProgressUpdate
+import batect.dockerclient.native.ContainerNetworkAttachment
+import batect.dockerclient.native.ContainerStatsCallback
 import batect.dockerclient.native.DockerClientHandle
 import batect.dockerclient.native.EventCallback
 import batect.dockerclient.native.PullImageProgressCallback
 import batect.dockerclient.native.PullImageProgressUpdate
+import batect.dockerclient.native.PullImageReturn
+import batect.dockerclient.native.allTagsImages
+import batect.dockerclient.native.changes
+import batect.dockerclient.native.containers
 import batect.dockerclient.native.ifFailed
+import batect.dockerclient.native.images
 import batect.dockerclient.native.nativeAPI
+import batect.dockerclient.native.references
 import batect.dockerclient.native.volumes
 import jnr.ffi.Pointer
 import kotlinx.coroutines.Dispatchers
@@ -33,6 +41,7 @@ import kotlinx.coroutines.coroutineScope
 import kotlinx.coroutines.launch
 import kotlinx.coroutines.withContext
 import kotlinx.datetime.Instant
+import okio.Path
 import kotlin.time.Duration
 
 internal actual class RealDockerClient actual constructor(configuration: DockerClientConfiguration) : DockerClient, AutoCloseable {
@@ -159,7 +168,35 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
-    override suspend fun pullImage(name: String, onProgressUpdate: ImagePullProgressReceiver): ImageReference {
+    override suspend fun connectContainerToNetwork(container: ContainerReference, attachment: NetworkAttachment) {
+        launchWithGolangContext { context ->
+            nativeAPI.ConnectContainerToNetwork(clientHandle, context.handle, container.id, ContainerNetworkAttachment(attachment)).ifFailed { error ->
+                throw ContainerNetworkConnectionFailedException(error)
+            }
+        }
+    }
+
+    override suspend fun disconnectContainerFromNetwork(container: ContainerReference, network: NetworkReference, force: Boolean) {
+        launchWithGolangContext { context ->
+            nativeAPI.DisconnectContainerFromNetwork(clientHandle, context.handle, container.id, network.id, force).ifFailed { error ->
+                throw ContainerNetworkDisconnectionFailedException(error)
+            }
+        }
+    }
+
+    override suspend fun pullImage(name: String, platform: String?, onProgressUpdate: ImagePullProgressReceiver): ImageReference =
+        pullImage(name, platform, false, onProgressUpdate) { ret -> ImageReference(ret.response!!) }
+
+    override suspend fun pullAllImageTags(name: String, platform: String?, onProgressUpdate: ImagePullProgressReceiver): Set<ImageReference> =
+        pullImage(name, platform, true, onProgressUpdate) { ret -> ret.allTagsImages.map { ImageReference(it) }.toSet() }
+
+    private suspend fun <T> pullImage(
+        name: String,
+        platform: String?,
+        allTags: Boolean,
+        onProgressUpdate: ImagePullProgressReceiver,
+        resultFromNative: (PullImageReturn) -> T,
+    ): T {
         var exceptionThrownInCallback: Throwable? = null
 
         val callback = object : PullImageProgressCallback {
@@ -178,7 +215,7 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
 
         return launchWithGolangContext { context ->
-            nativeAPI.PullImage(clientHandle, context.handle, name, callback, null)!!.use { ret ->
+            nativeAPI.PullImage(clientHandle, context.handle, name, platform ?: "", allTags, callback, null)!!.use { ret ->
                 if (ret.error != null) {
                     if (ret.error!!.type.get() == "main.ProgressCallbackFailedError") {
                         throw ImagePullFailedException("Image pull progress receiver threw an exception: $exceptionThrownInCallback", exceptionThrownInCallback, ret.error!!.type.get())
@@ -187,7 +224,40 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
                     throw ImagePullFailedException(ret.error!!)
                 }
 
-                ImageReference(ret.response!!)
+                resultFromNative(ret)
+            }
+        }
+    }
+
+    override suspend fun pushImage(name: String, onProgressUpdate: ImagePullProgressReceiver): String {
+        var exceptionThrownInCallback: Throwable? = null
+
+        val callback = object : PullImageProgressCallback {
+            override fun invoke(userData: Pointer?, progressPointer: Pointer?): Boolean {
+                try {
+                    val progress = PullImageProgressUpdate(progressPointer!!)
+                    onProgressUpdate(ImagePullProgressUpdate(progress))
+
+                    return true
+                } catch (t: Throwable) {
+                    exceptionThrownInCallback = t
+
+                    return false
+                }
+            }
+        }
+
+        return launchWithGolangContext { context ->
+            nativeAPI.PushImage(clientHandle, context.handle, name, callback, null)!!.use { ret ->
+                if (ret.error != null) {
+                    if (ret.error!!.type.get() == "main.ProgressCallbackFailedError") {
+                        throw ImagePushFailedException("Image push progress receiver threw an exception: $exceptionThrownInCallback", exceptionThrownInCallback, ret.error!!.type.get())
+                    }
+
+                    throw ImagePushFailedException(ret.error!!)
+                }
+
+                ret.digest.get()
             }
         }
     }
@@ -216,6 +286,82 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun inspectImage(nameOrID: String): ImageInspectionResult {
+        return launchWithGolangContext { context ->
+            nativeAPI.InspectImage(clientHandle, context.handle, nameOrID)!!.use { ret ->
+                if (ret.error != null) {
+                    throw ImageInspectionFailedException(ret.error!!)
+                }
+
+                ImageInspectionResult(ret.response!!)
+            }
+        }
+    }
+
+    override suspend fun listImages(all: Boolean, filters: Map<String, Set<String>>): List<ImageSummary> {
+        return launchWithGolangContext { context ->
+            nativeAPI.ListImages(clientHandle, context.handle, ListImagesRequest(all, filters))!!.use { ret ->
+                if (ret.error != null) {
+                    throw ImageListingFailedException(ret.error!!)
+                }
+
+                ret.images.map { ImageSummary(it) }
+            }
+        }
+    }
+
+    override suspend fun saveImages(names: Set<String>, output: TextOutput) {
+        output.prepareStream().use { stream ->
+            coroutineScope {
+                launch(IODispatcher) { stream.run() }
+
+                launchWithGolangContext { context ->
+                    nativeAPI.SaveImages(clientHandle, context.handle, SaveImagesRequest(names), stream.outputStreamHandle.toLong()).ifFailed { error ->
+                        throw ImageSaveFailedException(error)
+                    }
+                }
+            }
+        }
+    }
+
+    override suspend fun saveImagesToFile(names: Set<String>, destination: Path) {
+        launchWithGolangContext { context ->
+            nativeAPI.SaveImagesToFile(clientHandle, context.handle, SaveImagesRequest(names), destination.toString()).ifFailed { error ->
+                throw ImageSaveFailedException(error)
+            }
+        }
+    }
+
+    override suspend fun loadImages(input: TextInput): List<String> {
+        return input.prepareStream().use { stream ->
+            coroutineScope {
+                launch(IODispatcher) { stream.run() }
+
+                launchWithGolangContext { context ->
+                    nativeAPI.LoadImages(clientHandle, context.handle, stream.inputStreamHandle.toLong())!!.use { ret ->
+                        if (ret.error != null) {
+                            throw ImageLoadFailedException(ret.error!!)
+                        }
+
+                        ret.references
+                    }
+                }
+            }
+        }
+    }
+
+    override suspend fun loadImagesFromFile(source: Path): List<String> {
+        return launchWithGolangContext { context ->
+            nativeAPI.LoadImagesFromFile(clientHandle, context.handle, source.toString())!!.use { ret ->
+                if (ret.error != null) {
+                    throw ImageLoadFailedException(ret.error!!)
+                }
+
+                ret.references
+            }
+        }
+    }
+
     override suspend fun buildImage(spec: ImageBuildSpec, output: TextOutput, onProgressUpdate: ImageBuildProgressReceiver): ImageReference {
         var exceptionThrownInCallback: Throwable? = null
 
@@ -300,6 +446,38 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun restartContainer(container: ContainerReference, timeout: Duration) {
+        launchWithGolangContext { context ->
+            nativeAPI.RestartContainer(clientHandle, context.handle, container.id, timeout.inWholeSeconds).ifFailed { error ->
+                throw ContainerRestartFailedException(error)
+            }
+        }
+    }
+
+    override suspend fun killContainer(container: ContainerReference, signal: String) {
+        launchWithGolangContext { context ->
+            nativeAPI.KillContainer(clientHandle, context.handle, container.id, signal).ifFailed { error ->
+                throw ContainerKillFailedException(error)
+            }
+        }
+    }
+
+    override suspend fun pauseContainer(container: ContainerReference) {
+        launchWithGolangContext { context ->
+            nativeAPI.PauseContainer(clientHandle, context.handle, container.id).ifFailed { error ->
+                throw ContainerPauseFailedException(error)
+            }
+        }
+    }
+
+    override suspend fun unpauseContainer(container: ContainerReference) {
+        launchWithGolangContext { context ->
+            nativeAPI.UnpauseContainer(clientHandle, context.handle, container.id).ifFailed { error ->
+                throw ContainerUnpauseFailedException(error)
+            }
+        }
+    }
+
     override suspend fun removeContainer(container: ContainerReference, force: Boolean, removeVolumes: Boolean) {
         launchWithGolangContext { context ->
             nativeAPI.RemoveContainer(clientHandle, context.handle, container.id, force, removeVolumes).ifFailed { error ->
@@ -308,12 +486,42 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun renameContainer(container: ContainerReference, newName: String) {
+        launchWithGolangContext { context ->
+            nativeAPI.RenameContainer(clientHandle, context.handle, container.id, newName).ifFailed { error ->
+                throw ContainerRenameFailedException(error)
+            }
+        }
+    }
+
+    override suspend fun updateContainer(container: ContainerReference, update: ContainerUpdateSpec) {
+        launchWithGolangContext { context ->
+            nativeAPI.UpdateContainer(clientHandle, context.handle, container.id, UpdateContainerRequest(update)).ifFailed { error ->
+                throw ContainerUpdateFailedException(error)
+            }
+        }
+    }
+
+    override suspend fun commitContainer(spec: ContainerCommitSpec): ImageReference {
+        return launchWithGolangContext { context ->
+            nativeAPI.CommitContainer(clientHandle, context.handle, CommitContainerRequest(spec))!!.use { ret ->
+                if (ret.error != null) {
+                    throw ContainerCommitFailedException(ret.error!!)
+                }
+
+                ImageReference(ret.response!!)
+            }
+        }
+    }
+
     override suspend fun attachToContainerIO(
         container: ContainerReference,
         stdout: TextOutput?,
         stderr: TextOutput?,
         stdin: TextInput?,
         attachedNotification: ReadyNotification?,
+        includeHistoricalOutput: Boolean,
+        detachKeys: String?,
     ) {
         if (stdout == null && stderr == null && stdin == null) {
             attachedNotification?.markAsReady()
@@ -335,6 +543,8 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
                                 clientHandle,
                                 context.handle,
                                 container.id,
+                                includeHistoricalOutput,
+                                detachKeys ?: "",
                                 stdoutStream?.outputStreamHandle?.toLong() ?: 0,
                                 stderrStream?.outputStreamHandle?.toLong() ?: 0,
                                 stdinStream?.inputStreamHandle?.toLong() ?: 0,
@@ -354,6 +564,40 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun streamContainerLogs(
+        container: ContainerReference,
+        stdout: TextOutput?,
+        stderr: TextOutput?,
+        follow: Boolean,
+        showTimestamps: Boolean,
+        tailLines: Long?,
+        since: Instant?,
+        until: Instant?,
+    ) {
+        val request = StreamContainerLogsRequest(container, follow, showTimestamps, tailLines, since, until)
+
+        stdout?.prepareStream().use { stdoutStream ->
+            stderr?.prepareStream().use { stderrStream ->
+                coroutineScope {
+                    launch(IODispatcher) { stdoutStream?.run() }
+                    launch(IODispatcher) { stderrStream?.run() }
+
+                    launchWithGolangContext { context ->
+                        nativeAPI.StreamContainerLogs(
+                            clientHandle,
+                            context.handle,
+                            request,
+                            stdoutStream?.outputStreamHandle?.toLong() ?: 0,
+                            stderrStream?.outputStreamHandle?.toLong() ?: 0,
+                        ).ifFailed { error ->
+                            throw StreamingContainerLogsFailedException(error)
+                        }
+                    }
+                }
+            }
+        }
+    }
+
     override suspend fun waitForContainerToExit(container: ContainerReference, waitingNotification: ReadyNotification?): Long {
         return launchWithGolangContext { context ->
             val callback = ReadyCallback(waitingNotification)
@@ -372,6 +616,18 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun waitForContainerToBeHealthy(container: ContainerReference): ContainerHealthWaitResult {
+        return launchWithGolangContext { context ->
+            nativeAPI.WaitForContainerToBeHealthy(clientHandle, context.handle, container.id)!!.use { ret ->
+                if (ret.error != null) {
+                    throw ContainerHealthWaitFailedException(ret.error!!)
+                }
+
+                ContainerHealthWaitResult(ret.outcome.get(), ret.lastHealthcheckOutput.get(), ret.exitCode.get())
+            }
+        }
+    }
+
     override suspend fun inspectContainer(idOrName: String): ContainerInspectionResult {
         return launchWithGolangContext { context ->
             nativeAPI.InspectContainer(clientHandle, context.handle, idOrName)!!.use { ret ->
@@ -392,6 +648,106 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun downloadFromContainer(container: ContainerReference, sourcePath: String, destinationDirectory: Path, preserveOwnership: Boolean) {
+        launchWithGolangContext { context ->
+            nativeAPI.DownloadFromContainer(clientHandle, context.handle, container.id, sourcePath, destinationDirectory.toString(), preserveOwnership).ifFailed { error ->
+                throw ContainerDownloadFailedException(error)
+            }
+        }
+    }
+
+    override suspend fun downloadArchiveFromContainer(container: ContainerReference, sourcePath: String, output: TextOutput) {
+        output.prepareStream().use { stream ->
+            coroutineScope {
+                launch(IODispatcher) { stream.run() }
+
+                launchWithGolangContext { context ->
+                    nativeAPI.DownloadArchiveFromContainer(clientHandle, context.handle, container.id, sourcePath, stream.outputStreamHandle.toLong()).ifFailed { error ->
+                        throw ContainerDownloadFailedException(error)
+                    }
+                }
+            }
+        }
+    }
+
+    override suspend fun listContainerFilesystemChanges(container: ContainerReference): List<ContainerFilesystemChange> {
+        return launchWithGolangContext { context ->
+            nativeAPI.ContainerChanges(clientHandle, context.handle, container.id)!!.use { ret ->
+                if (ret.error != null) {
+                    throw ContainerFilesystemChangesRetrievalFailedException(ret.error!!)
+                }
+
+                ret.changes.map { ContainerFilesystemChange(it) }
+            }
+        }
+    }
+
+    override suspend fun listContainerProcesses(container: ContainerReference, psArguments: String): ContainerProcessList {
+        return launchWithGolangContext { context ->
+            nativeAPI.ContainerTop(clientHandle, context.handle, container.id, psArguments)!!.use { ret ->
+                if (ret.error != null) {
+                    throw ContainerProcessListingFailedException(ret.error!!)
+                }
+
+                ContainerProcessList(ret.response!!)
+            }
+        }
+    }
+
+    override suspend fun exportContainer(container: ContainerReference, output: TextOutput) {
+        output.prepareStream().use { stream ->
+            coroutineScope {
+                launch(IODispatcher) { stream.run() }
+
+                launchWithGolangContext { context ->
+                    nativeAPI.ExportContainer(clientHandle, context.handle, container.id, stream.outputStreamHandle.toLong()).ifFailed { error ->
+                        throw ContainerExportFailedException(error)
+                    }
+                }
+            }
+        }
+    }
+
+    override suspend fun exportContainerToFile(container: ContainerReference, destination: Path) {
+        launchWithGolangContext { context ->
+            nativeAPI.ExportContainerToFile(clientHandle, context.handle, container.id, destination.toString()).ifFailed { error ->
+                throw ContainerExportFailedException(error)
+            }
+        }
+    }
+
+    override suspend fun listContainers(all: Boolean, filters: Map<String, Set<String>>): List<ContainerSummary> {
+        return launchWithGolangContext { context ->
+            nativeAPI.ListContainers(clientHandle, context.handle, ListContainersRequest(all, filters))!!.use { ret ->
+                if (ret.error != null) {
+                    throw ContainerListingFailedException(ret.error!!)
+                }
+
+                ret.containers.map { ContainerSummary(it) }
+            }
+        }
+    }
+
+    override suspend fun pruneContainers(filters: Map<String, Set<String>>): ContainerPruneResult {
+        return launchWithGolangContext { context ->
+            nativeAPI.PruneContainers(clientHandle, context.handle, PruneContainersRequest(filters))!!.use { ret ->
+                if (ret.error != null) {
+                    throw ContainerPruneFailedException(ret.error!!)
+                }
+
+                ContainerPruneResult(ret.response!!)
+            }
+        }
+    }
+
+    override suspend fun uploadPathsToContainer(container: ContainerReference, paths: Set<UploadPath>, destinationPath: String, followSymlinks: Boolean) {
+        return launchWithGolangContext { context ->
+            nativeAPI.UploadPathsToContainer(clientHandle, context.handle, container.id, UploadPathsToContainerRequest(paths, followSymlinks), destinationPath).ifFailed { error ->
+                throw ContainerUploadFailedException(error)
+            }
+        }
+    }
+
     override suspend fun streamEvents(since: Instant?, until: Instant?, filters: Map<String, Set<String>>, onEventReceived: EventHandler) {
         var exceptionThrownInCallback: Throwable? = null
         val streamingAbortedException = Exception("Event handler aborted streaming.")
@@ -429,6 +785,43 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun streamContainerStats(container: ContainerReference, onStatsReceived: ContainerStatsHandler) {
+        var exceptionThrownInCallback: Throwable? = null
+        val streamingAbortedException = Exception("Container stats handler aborted streaming.")
+
+        val callback = object : ContainerStatsCallback {
+            override fun invoke(userData: Pointer?, statsPointer: Pointer?): Boolean {
+                try {
+                    val stats = batect.dockerclient.native.ContainerStatsSample(statsPointer!!)
+
+                    if (onStatsReceived(ContainerStatsSample(stats)) == ContainerStatsHandlerAction.Stop) {
+                        throw streamingAbortedException
+                    }
+
+                    return true
+                } catch (t: Throwable) {
+                    exceptionThrownInCallback = t
+
+                    return false
+                }
+            }
+        }
+
+        launchWithGolangContext { context ->
+            nativeAPI.StreamContainerStats(clientHandle, context.handle, container.id, callback, null).ifFailed { error ->
+                if (error.type.get() != "main.StatsCallbackFailedError") {
+                    throw StreamingContainerStatsFailedException(error)
+                }
+
+                if (exceptionThrownInCallback != streamingAbortedException) {
+                    throw StreamingContainerStatsFailedException("Container stats receiver threw an exception: $exceptionThrownInCallback", exceptionThrownInCallback, error.type.get())
+                } else {
+                    // Stats receiver aborted streaming - do not propagate the exception.
+                }
+            }
+        }
+    }
+
     override suspend fun createExec(spec: ContainerExecSpec): ContainerExecReference {
         return launchWithGolangContext { context ->
             nativeAPI.CreateExec(clientHandle, context.handle, CreateExecRequest(spec))!!.use { ret ->
@@ -461,6 +854,22 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun resizeContainerTTY(container: ContainerReference, height: Long, width: Long) {
+        launchWithGolangContext { context ->
+            nativeAPI.ResizeContainerTTY(clientHandle, context.handle, container.id, height, width).ifFailed { error ->
+                throw ContainerTTYResizeFailedException(error)
+            }
+        }
+    }
+
+    override suspend fun resizeExecTTY(exec: ContainerExecReference, height: Long, width: Long) {
+        launchWithGolangContext { context ->
+            nativeAPI.ResizeExecTTY(clientHandle, context.handle, exec.id, height, width).ifFailed { error ->
+                throw ExecTTYResizeFailedException(error)
+            }
+        }
+    }
+
     override suspend fun startAndAttachToExec(exec: ContainerExecReference, attachTTY: Boolean, stdout: TextOutput?, stderr: TextOutput?, stdin: TextInput?) {
         stdout?.prepareStream().use { stdoutStream ->
             stderr?.prepareStream().use { stderrStream ->
@@ -36,11 +36,31 @@ internal interface API {
     fun CreateContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In request: CreateContainerRequest): CreateContainerReturn?
     fun StartContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String): Error?
     fun StopContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In timeoutSeconds: Long): Error?
+    fun RestartContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In timeoutSeconds: Long): Error?
+    fun KillContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In signal: kotlin.String): Error?
+    fun PauseContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String): Error?
+    fun UnpauseContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String): Error?
+    fun ResizeContainerTTY(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In height: Long, @In width: Long): Error?
+    fun RenameContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In newName: kotlin.String): Error?
+    fun UpdateContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In request: UpdateContainerRequest): Error?
+    fun CommitContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In request: CommitContainerRequest): CommitContainerReturn?
     fun RemoveContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In force: Boolean, @In removeVolumes: Boolean): Error?
-    fun AttachToContainerOutput(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In stdoutStreamHandle: OutputStreamHandle, @In stderrStreamHandle: OutputStreamHandle, @In stdinStreamHandle: InputStreamHandle, @In onReady: ReadyCallback, @In callbackUserData: Pointer?): Error?
+    fun AttachToContainerOutput(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In includeHistoricalOutput: Boolean, @In detachKeys: kotlin.String, @In stdoutStreamHandle: OutputStreamHandle, @In stderrStreamHandle: OutputStreamHandle, @In stdinStreamHandle: InputStreamHandle, @In onReady: ReadyCallback, @In callbackUserData: Pointer?): Error?
     fun WaitForContainerToExit(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In onReady: ReadyCallback, @In callbackUserData: Pointer?): WaitForContainerToExitReturn?
     fun InspectContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In idOrName: kotlin.String): InspectContainerReturn?
+    fun ListContainers(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In request: ListContainersRequest): ListContainersReturn?
+    fun PruneContainers(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In request: PruneContainersRequest): PruneContainersReturn?
     fun UploadToContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In containerID: kotlin.String, @In request: UploadToContainerRequest, @In destinationPath: kotlin.String): Error?
+    fun DownloadFromContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In containerID: kotlin.String, @In sourcePath: kotlin.String, @In destinationDirectory: kotlin.String, @In preserveOwnership: Boolean): Error?
+    fun DownloadArchiveFromContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In containerID: kotlin.String, @In sourcePath: kotlin.String, @In outputStreamHandle: OutputStreamHandle): Error?
+    fun ContainerChanges(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String): ContainerChangesReturn?
+    fun ContainerTop(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In psArguments: kotlin.String): ContainerTopReturn?
+    fun ExportContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In outputStreamHandle: OutputStreamHandle): Error?
+    fun ExportContainerToFile(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In destinationPath: kotlin.String): Error?
+    fun WaitForContainerToBeHealthy(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String): WaitForContainerToBeHealthyReturn?
+    fun StreamContainerLogs(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In request: StreamContainerLogsRequest, @In stdoutStreamHandle: OutputStreamHandle, @In stderrStreamHandle: OutputStreamHandle): Error?
+    fun StreamContainerStats(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In onStats: ContainerStatsCallback, @In callbackUserData: Pointer?): Error?
+    fun UploadPathsToContainer(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In containerID: kotlin.String, @In request: UploadPathsToContainerRequest, @In destinationPath: kotlin.String): Error?
     fun CreateContext(): ContextHandle
     fun CancelContext(@In contextHandle: ContextHandle)
     fun DestroyContext(@In contextHandle: ContextHandle): Error?
@@ -51,12 +71,20 @@ internal interface API {
     fun StartExecDetached(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String): Error?
     fun InspectExec(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String): InspectExecReturn?
     fun StartAndAttachToExec(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In attachTTY: Boolean, @In stdoutStreamHandle: OutputStreamHandle, @In stderrStreamHandle: OutputStreamHandle, @In stdinStreamHandle: InputStreamHandle): Error?
+    fun ResizeExecTTY(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String, @In height: Long, @In width: Long): Error?
     fun DeleteImage(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In ref: kotlin.String, @In force: Boolean): Error?
     fun GetImage(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In ref: kotlin.String): GetImageReturn?
+    fun InspectImage(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In ref: kotlin.String): InspectImageReturn?
+    fun ListImages(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In request: ListImagesRequest): ListImagesReturn?
     fun ValidateImageTag(@In tag: kotlin.String): Error?
+    fun SaveImages(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In request: SaveImagesRequest, @In outputStreamHandle: OutputStreamHandle): Error?
+    fun SaveImagesToFile(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In request: SaveImagesRequest, @In destinationPath: kotlin.String): Error?
+    fun LoadImages(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In inputStreamHandle: InputStreamHandle): LoadImagesReturn?
+    fun LoadImagesFromFile(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In sourcePath: kotlin.String): LoadImagesReturn?
     fun BuildImage(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In request: BuildImageRequest, @In outputStreamHandle: OutputStreamHandle, @In onProgressUpdate: BuildImageProgressCallback, @In callbackUserData: Pointer?): BuildImageReturn?
     fun PruneImageBuildCache(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle): Error?
-    fun PullImage(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In ref: kotlin.String, @In onProgressUpdate: PullImageProgressCallback, @In callbackUserData: Pointer?): PullImageReturn?
+    fun PullImage(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In ref: kotlin.String, @In platform: kotlin.String, @In allTags: Boolean, @In onProgressUpdate: PullImageProgressCallback, @In callbackUserData: Pointer?): PullImageReturn?
+    fun PushImage(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In ref: kotlin.String, @In onProgressUpdate: PullImageProgressCallback, @In callbackUserData: Pointer?): PushImageReturn?
     fun CreateInputPipe(): CreateInputPipeReturn?
     fun CloseInputPipeWriteEnd(@In handle: InputStreamHandle): Error?
     fun DisposeInputPipe(@In handle: InputStreamHandle): Error?
@@ -65,6 +93,8 @@ internal interface API {
     fun CreateNetwork(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In name: kotlin.String, @In driver: kotlin.String): CreateNetworkReturn?
     fun DeleteNetwork(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In id: kotlin.String): Error?
     fun GetNetworkByNameOrID(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In searchFor: kotlin.String): GetNetworkByNameOrIDReturn?
+    fun ConnectContainerToNetwork(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In containerID: kotlin.String, @In attachment: ContainerNetworkAttachment): Error?
+    fun DisconnectContainerFromNetwork(@In clientHandle: DockerClientHandle, @In contextHandle: ContextHandle, @In containerID: kotlin.String, @In networkReference: kotlin.String, @In force: Boolean): Error?
     fun GetEnvironmentVariable(@In name: kotlin.String): kotlin.String?
     fun UnsetEnvironmentVariable(@In name: kotlin.String): Error?
     fun SetEnvironmentVariable(@In name: kotlin.String, @In value: kotlin.String): Error?
@@ -151,6 +181,14 @@ internal interface API {
     fun AllocDeviceMount(): DeviceMount?
     fun FreeExposedPort(@In value: ExposedPort)
     fun AllocExposedPort(): ExposedPort?
+    fun FreeUlimit(@In value: Ulimit)
+    fun AllocUlimit(): Ulimit?
+    fun FreeContainerMount(@In value: ContainerMount)
+    fun AllocContainerMount(): ContainerMount?
+    fun FreeRestartPolicy(@In value: RestartPolicy)
+    fun AllocRestartPolicy(): RestartPolicy?
+    fun FreeContainerNetworkAttachment(@In value: ContainerNetworkAttachment)
+    fun AllocContainerNetworkAttachment(): ContainerNetworkAttachment?
     fun FreeCreateContainerRequest(@In value: CreateContainerRequest)
     fun AllocCreateContainerRequest(): CreateContainerRequest?
     fun FreeCreateContainerReturn(@In value: CreateContainerReturn)
@@ -171,6 +209,14 @@ internal interface API {
     fun AllocContainerLogConfig(): ContainerLogConfig?
     fun FreeContainerHostConfig(@In value: ContainerHostConfig)
     fun AllocContainerHostConfig(): ContainerHostConfig?
+    fun FreePortBinding(@In value: PortBinding)
+    fun AllocPortBinding(): PortBinding?
+    fun FreeContainerMountPoint(@In value: ContainerMountPoint)
+    fun AllocContainerMountPoint(): ContainerMountPoint?
+    fun FreeContainerNetworkEndpoint(@In value: ContainerNetworkEndpoint)
+    fun AllocContainerNetworkEndpoint(): ContainerNetworkEndpoint?
+    fun FreeContainerNetworkSettings(@In value: ContainerNetworkSettings)
+    fun AllocContainerNetworkSettings(): ContainerNetworkSettings?
     fun FreeContainerInspectionResult(@In value: ContainerInspectionResult)
     fun AllocContainerInspectionResult(): ContainerInspectionResult?
     fun FreeInspectContainerReturn(@In value: InspectContainerReturn)
@@ -199,4 +245,60 @@ internal interface API {
     fun AllocInspectExecResult(): InspectExecResult?
     fun FreeInspectExecReturn(@In value: InspectExecReturn)
     fun AllocInspectExecReturn(): InspectExecReturn?
+    fun FreeListContainersRequest(@In value: ListContainersRequest)
+    fun AllocListContainersRequest(): ListContainersRequest?
+    fun FreeContainerSummary(@In value: ContainerSummary)
+    fun AllocContainerSummary(): ContainerSummary?
+    fun FreeListContainersReturn(@In value: ListContainersReturn)
+    fun AllocListContainersReturn(): ListContainersReturn?
+    fun FreeStreamContainerLogsRequest(@In value: StreamContainerLogsRequest)
+    fun AllocStreamContainerLogsRequest(): StreamContainerLogsRequest?
+    fun FreeContainerStatsSample(@In value: ContainerStatsSample)
+    fun AllocContainerStatsSample(): ContainerStatsSample?
+    fun FreeUploadPath(@In value: UploadPath)
+    fun AllocUploadPath(): UploadPath?
+    fun FreeUploadPathsToContainerRequest(@In value: UploadPathsToContainerRequest)
+    fun AllocUploadPathsToContainerRequest(): UploadPathsToContainerRequest?
+    fun FreeWaitForContainerToBeHealthyReturn(@In value: WaitForContainerToBeHealthyReturn)
+    fun AllocWaitForContainerToBeHealthyReturn(): WaitForContainerToBeHealthyReturn?
+    fun FreeUpdateContainerRequest(@In value: UpdateContainerRequest)
+    fun AllocUpdateContainerRequest(): UpdateContainerRequest?
+    fun FreeCommitContainerRequest(@In value: CommitContainerRequest)
+    fun AllocCommitContainerRequest(): CommitContainerRequest?
+    fun FreeCommitContainerReturn(@In value: CommitContainerReturn)
+    fun AllocCommitContainerReturn(): CommitContainerReturn?
+    fun FreeContainerFilesystemChange(@In value: ContainerFilesystemChange)
+    fun AllocContainerFilesystemChange(): ContainerFilesystemChange?
+    fun FreeContainerChangesReturn(@In value: ContainerChangesReturn)
+    fun AllocContainerChangesReturn(): ContainerChangesReturn?
+    fun FreeContainerProcess(@In value: ContainerProcess)
+    fun AllocContainerProcess(): ContainerProcess?
+    fun FreeContainerTopResult(@In value: ContainerTopResult)
+    fun AllocContainerTopResult(): ContainerTopResult?
+    fun FreeContainerTopReturn(@In value: ContainerTopReturn)
+    fun AllocContainerTopReturn(): ContainerTopReturn?
+    fun FreePruneContainersRequest(@In value: PruneContainersRequest)
+    fun AllocPruneContainersRequest(): PruneContainersRequest?
+    fun FreePruneContainersResult(@In value: PruneContainersResult)
+    fun AllocPruneContainersResult(): PruneContainersResult?
+    fun FreePruneContainersReturn(@In value: PruneContainersReturn)
+    fun AllocPruneContainersReturn(): PruneContainersReturn?
+    fun FreeImageConfig(@In value: ImageConfig)
+    fun AllocImageConfig(): ImageConfig?
+    fun FreeImageInspectionResult(@In value: ImageInspectionResult)
+    fun AllocImageInspectionResult(): ImageInspectionResult?
+    fun FreeInspectImageReturn(@In value: InspectImageReturn)
+    fun AllocInspectImageReturn(): InspectImageReturn?
+    fun FreeListImagesRequest(@In value: ListImagesRequest)
+    fun AllocListImagesRequest(): ListImagesRequest?
+    fun FreeImageSummary(@In value: ImageSummary)
+    fun AllocImageSummary(): ImageSummary?
+    fun FreeListImagesReturn(@In value: ListImagesReturn)
+    fun AllocListImagesReturn(): ListImagesReturn?
+    fun FreePushImageReturn(@In value: PushImageReturn)
+    fun AllocPushImageReturn(): PushImageReturn?
+    fun FreeSaveImagesRequest(@In value: SaveImagesRequest)
+    fun AllocSaveImagesRequest(): SaveImagesRequest?
+    fun FreeLoadImagesReturn(@In value: LoadImagesReturn)
+    fun AllocLoadImagesReturn(): LoadImagesReturn?
 }
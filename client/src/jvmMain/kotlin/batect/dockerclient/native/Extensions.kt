@@ -34,6 +34,12 @@ internal val ListAllVolumesReturn.volumes by ReadOnlyList(
     ::VolumeReference,
 )
 
+internal val PullImageReturn.allTagsImages by ReadOnlyList(
+    PullImageReturn::allTagsImagesCount,
+    PullImageReturn::allTagsImagesPointer,
+    ::ImageReference,
+)
+
 internal var BuildImageRequest.buildArgs by WriteOnlyList<BuildImageRequest, StringPair>(
     BuildImageRequest::buildArgsCount,
     BuildImageRequest::buildArgsPointer,
@@ -150,6 +156,105 @@ internal var CreateContainerRequest.labels by WriteOnlyList<CreateContainerReque
     CreateContainerRequest::labelsPointer,
 )
 
+internal var CreateContainerRequest.ulimits by WriteOnlyList<CreateContainerRequest, batect.dockerclient.Ulimit>(
+    CreateContainerRequest::ulimitsCount,
+    CreateContainerRequest::ulimitsPointer,
+    ::ulimitToNative,
+)
+
+internal var CreateContainerRequest.mounts by WriteOnlyList<CreateContainerRequest, batect.dockerclient.Mount>(
+    CreateContainerRequest::mountsCount,
+    CreateContainerRequest::mountsPointer,
+    ::mountToNative,
+)
+
+internal var ContainerMount.volumeDriverOptions by WriteOnlyList<ContainerMount, StringPair>(
+    ContainerMount::volumeDriverOptionsCount,
+    ContainerMount::volumeDriverOptionsPointer,
+)
+
+internal var ContainerMount.volumeLabels by WriteOnlyList<ContainerMount, StringPair>(
+    ContainerMount::volumeLabelsCount,
+    ContainerMount::volumeLabelsPointer,
+)
+
+internal var CreateContainerRequest.seLinuxLabels by WriteOnlyList<CreateContainerRequest, String>(
+    CreateContainerRequest::seLinuxLabelsCount,
+    CreateContainerRequest::seLinuxLabelsPointer,
+    ::stringToPointer,
+)
+
+internal var CreateContainerRequest.sysctls by WriteOnlyList<CreateContainerRequest, StringPair>(
+    CreateContainerRequest::sysctlsCount,
+    CreateContainerRequest::sysctlsPointer,
+)
+
+internal var CreateContainerRequest.groupAdd by WriteOnlyList<CreateContainerRequest, String>(
+    CreateContainerRequest::groupAddCount,
+    CreateContainerRequest::groupAddPointer,
+    ::stringToPointer,
+)
+
+internal var CreateContainerRequest.networks by WriteOnlyList<CreateContainerRequest, batect.dockerclient.NetworkAttachment>(
+    CreateContainerRequest::networksCount,
+    CreateContainerRequest::networksPointer,
+    ::networkAttachmentToNative,
+)
+
+internal var ContainerNetworkAttachment.aliases by WriteOnlyList<ContainerNetworkAttachment, String>(
+    ContainerNetworkAttachment::aliasesCount,
+    ContainerNetworkAttachment::aliasesPointer,
+    ::stringToPointer,
+)
+
+internal var ContainerNetworkAttachment.links by WriteOnlyList<ContainerNetworkAttachment, String>(
+    ContainerNetworkAttachment::linksCount,
+    ContainerNetworkAttachment::linksPointer,
+    ::stringToPointer,
+)
+
+internal val ContainerNetworkSettings.ports by ReadOnlyList(
+    ContainerNetworkSettings::portsCount,
+    ContainerNetworkSettings::portsPointer,
+    ::PortBinding,
+)
+
+internal val ContainerNetworkSettings.networks by ReadOnlyList(
+    ContainerNetworkSettings::networksCount,
+    ContainerNetworkSettings::networksPointer,
+    ::ContainerNetworkEndpoint,
+)
+
+internal val ContainerNetworkEndpoint.aliases by ReadOnlyList(
+    ContainerNetworkEndpoint::aliasesCount,
+    ContainerNetworkEndpoint::aliasesPointer,
+    ::pointerToString,
+)
+
+internal val ContainerConfig.environmentVariables by ReadOnlyList(
+    ContainerConfig::environmentVariablesCount,
+    ContainerConfig::environmentVariablesPointer,
+    ::pointerToString,
+)
+
+internal val ContainerConfig.command by ReadOnlyList(
+    ContainerConfig::commandCount,
+    ContainerConfig::commandPointer,
+    ::pointerToString,
+)
+
+internal val ContainerConfig.entrypoint by ReadOnlyList(
+    ContainerConfig::entrypointCount,
+    ContainerConfig::entrypointPointer,
+    ::pointerToString,
+)
+
+internal val ContainerInspectionResult.mounts by ReadOnlyList(
+    ContainerInspectionResult::mountsCount,
+    ContainerInspectionResult::mountsPointer,
+    ::ContainerMountPoint,
+)
+
 internal val ContainerLogConfig.config by ReadOnlyList(
     ContainerLogConfig::configCount,
     ContainerLogConfig::configPointer,
@@ -192,11 +297,170 @@ internal var UploadToContainerRequest.files by WriteOnlyList<UploadToContainerRe
     ::uploadFileToNative,
 )
 
+internal var UploadPathsToContainerRequest.paths by WriteOnlyList<UploadPathsToContainerRequest, batect.dockerclient.UploadPath>(
+    UploadPathsToContainerRequest::pathsCount,
+    UploadPathsToContainerRequest::pathsPointer,
+    ::uploadPathToNative,
+)
+
+internal var UploadPath.excludePatterns by WriteOnlyList<UploadPath, String>(
+    UploadPath::excludePatternsCount,
+    UploadPath::excludePatternsPointer,
+    ::stringToPointer,
+)
+
 internal var StreamEventsRequest.filters by WriteOnlyList<StreamEventsRequest, StringToStringListPair>(
     StreamEventsRequest::filtersCount,
     StreamEventsRequest::filtersPointer,
 )
 
+internal var ListContainersRequest.filters by WriteOnlyList<ListContainersRequest, StringToStringListPair>(
+    ListContainersRequest::filtersCount,
+    ListContainersRequest::filtersPointer,
+)
+
+internal val ListContainersReturn.containers by ReadOnlyList(
+    ListContainersReturn::containersCount,
+    ListContainersReturn::containersPointer,
+    ::ContainerSummary,
+)
+
+internal val ContainerSummary.names by ReadOnlyList(
+    ContainerSummary::namesCount,
+    ContainerSummary::namesPointer,
+    ::pointerToString,
+)
+
+internal val ContainerSummary.labels by ReadOnlyList(
+    ContainerSummary::labelsCount,
+    ContainerSummary::labelsPointer,
+    ::StringPair,
+)
+
+internal val ContainerSummary.ports by ReadOnlyList(
+    ContainerSummary::portsCount,
+    ContainerSummary::portsPointer,
+    ::PortBinding,
+)
+
+internal val ContainerChangesReturn.changes by ReadOnlyList(
+    ContainerChangesReturn::changesCount,
+    ContainerChangesReturn::changesPointer,
+    ::ContainerFilesystemChange,
+)
+
+internal val ContainerTopResult.titles by ReadOnlyList(
+    ContainerTopResult::titlesCount,
+    ContainerTopResult::titlesPointer,
+    ::pointerToString,
+)
+
+internal val ContainerTopResult.processes by ReadOnlyList(
+    ContainerTopResult::processesCount,
+    ContainerTopResult::processesPointer,
+    ::ContainerProcess,
+)
+
+internal val ContainerProcess.values by ReadOnlyList(
+    ContainerProcess::valuesCount,
+    ContainerProcess::valuesPointer,
+    ::pointerToString,
+)
+
+internal var PruneContainersRequest.filters by WriteOnlyList<PruneContainersRequest, StringToStringListPair>(
+    PruneContainersRequest::filtersCount,
+    PruneContainersRequest::filtersPointer,
+)
+
+internal val PruneContainersResult.containerIDs by ReadOnlyList(
+    PruneContainersResult::containerIDsCount,
+    PruneContainersResult::containerIDsPointer,
+    ::pointerToString,
+)
+
+internal val ImageInspectionResult.repoTags by ReadOnlyList(
+    ImageInspectionResult::repoTagsCount,
+    ImageInspectionResult::repoTagsPointer,
+    ::pointerToString,
+)
+
+internal val ImageInspectionResult.repoDigests by ReadOnlyList(
+    ImageInspectionResult::repoDigestsCount,
+    ImageInspectionResult::repoDigestsPointer,
+    ::pointerToString,
+)
+
+internal val ImageInspectionResult.rootFSLayers by ReadOnlyList(
+    ImageInspectionResult::rootFSLayersCount,
+    ImageInspectionResult::rootFSLayersPointer,
+    ::pointerToString,
+)
+
+internal val ImageConfig.environmentVariables by ReadOnlyList(
+    ImageConfig::environmentVariablesCount,
+    ImageConfig::environmentVariablesPointer,
+    ::pointerToString,
+)
+
+internal val ImageConfig.entrypoint by ReadOnlyList(
+    ImageConfig::entrypointCount,
+    ImageConfig::entrypointPointer,
+    ::pointerToString,
+)
+
+internal val ImageConfig.command by ReadOnlyList(
+    ImageConfig::commandCount,
+    ImageConfig::commandPointer,
+    ::pointerToString,
+)
+
+internal val ImageConfig.exposedPorts by ReadOnlyList(
+    ImageConfig::exposedPortsCount,
+    ImageConfig::exposedPortsPointer,
+    ::pointerToString,
+)
+
+internal val ImageConfig.volumes by ReadOnlyList(
+    ImageConfig::volumesCount,
+    ImageConfig::volumesPointer,
+    ::pointerToString,
+)
+
+internal val ImageConfig.labels by ReadOnlyList(
+    ImageConfig::labelsCount,
+    ImageConfig::labelsPointer,
+    ::StringPair,
+)
+
+internal var ListImagesRequest.filters by WriteOnlyList<ListImagesRequest, StringToStringListPair>(
+    ListImagesRequest::filtersCount,
+    ListImagesRequest::filtersPointer,
+)
+
+internal val ListImagesReturn.images by ReadOnlyList(
+    ListImagesReturn::imagesCount,
+    ListImagesReturn::imagesPointer,
+    ::ImageSummary,
+)
+
+internal val ImageSummary.repoTags by ReadOnlyList(
+    ImageSummary::repoTagsCount,
+    ImageSummary::repoTagsPointer,
+    ::pointerToString,
+)
+
+internal val ImageSummary.repoDigests by ReadOnlyList(
+    ImageSummary::repoDigestsCount,
+    ImageSummary::repoDigestsPointer,
+    ::pointerToString,
+)
+
+internal val ImageSummary.labels by ReadOnlyList(
+    ImageSummary::labelsCount,
+    ImageSummary::labelsPointer,
+    ::StringPair,
+)
+
 internal var CreateExecRequest.command by WriteOnlyList<CreateExecRequest, String>(
     CreateExecRequest::commandCount,
     CreateExecRequest::commandPointer,
@@ -209,6 +473,29 @@ internal var CreateExecRequest.environmentVariables by WriteOnlyList<CreateExecR
     ::stringToPointer,
 )
 
+internal var CommitContainerRequest.changes by WriteOnlyList<CommitContainerRequest, String>(
+    CommitContainerRequest::changesCount,
+    CommitContainerRequest::changesPointer,
+    ::stringToPointer,
+)
+
+internal var CommitContainerRequest.labels by WriteOnlyList<CommitContainerRequest, StringPair>(
+    CommitContainerRequest::labelsCount,
+    CommitContainerRequest::labelsPointer,
+)
+
+internal var SaveImagesRequest.references by WriteOnlyList<SaveImagesRequest, String>(
+    SaveImagesRequest::referencesCount,
+    SaveImagesRequest::referencesPointer,
+    ::stringToPointer,
+)
+
+internal val LoadImagesReturn.references by ReadOnlyList(
+    LoadImagesReturn::referencesCount,
+    LoadImagesReturn::referencesPointer,
+    ::pointerToString,
+)
+
 internal var StringToStringListPair.values by WriteOnlyList<StringToStringListPair, String>(
     StringToStringListPair::valuesCount,
     StringToStringListPair::valuesPointer,
@@ -316,10 +603,74 @@ private fun exposedPortToNative(value: batect.dockerclient.ExposedPort, @Suppres
     port.localPort.set(value.localPort)
     port.containerPort.set(value.containerPort)
     port.protocol.set(value.protocol)
+    port.localIP.set(value.localIP)
+    port.localPortRangeEnd.set(value.localPortRangeEnd ?: 0)
+    port.containerPortRangeEnd.set(value.containerPortRangeEnd ?: 0)
 
     return Struct.getMemory(port)
 }
 
+private fun ulimitToNative(value: batect.dockerclient.Ulimit, @Suppress("UNUSED_PARAMETER") memoryManager: MemoryManager): Pointer {
+    val runtime = Runtime.getRuntime(nativeAPI)
+    val ulimit = Ulimit(runtime)
+
+    ulimit.name.set(value.name)
+    ulimit.soft.set(value.soft)
+    ulimit.hard.set(value.hard)
+
+    return Struct.getMemory(ulimit)
+}
+
+private fun mountToNative(value: batect.dockerclient.Mount, @Suppress("UNUSED_PARAMETER") memoryManager: MemoryManager): Pointer {
+    val runtime = Runtime.getRuntime(nativeAPI)
+    val mount = ContainerMount(runtime)
+
+    mount.target.set(value.containerPath)
+    mount.readOnly.set(value.readOnly)
+    mount.consistency.set(value.consistency)
+    mount.volumeDriverOptions = emptyList()
+    mount.volumeLabels = emptyList()
+
+    when (value) {
+        is batect.dockerclient.BindTypeMount -> {
+            mount.type.set("bind")
+            mount.source.set(value.localPath.toString())
+            mount.bindPropagation.set(value.propagation)
+        }
+        is batect.dockerclient.VolumeTypeMount -> {
+            mount.type.set("volume")
+            mount.source.set(value.volume?.name)
+            mount.volumeNoCopy.set(value.noCopy)
+            mount.volumeSubpath.set(value.subpath)
+            mount.volumeDriver.set(value.driver)
+            mount.volumeDriverOptions = value.driverOptions.map { StringPair(it.key, it.value) }
+            mount.volumeLabels = value.labels.map { StringPair(it.key, it.value) }
+        }
+        is batect.dockerclient.TmpfsTypeMount -> {
+            mount.type.set("tmpfs")
+            mount.tmpfsSizeInBytes.set(value.sizeInBytes ?: 0)
+            mount.tmpfsMode.set(value.mode ?: 0)
+        }
+    }
+
+    return Struct.getMemory(mount)
+}
+
+internal fun ContainerNetworkAttachment(value: batect.dockerclient.NetworkAttachment): ContainerNetworkAttachment {
+    val attachment = ContainerNetworkAttachment(Runtime.getRuntime(nativeAPI))
+
+    attachment.networkReference.set(value.network.id)
+    attachment.aliases = value.aliases
+    attachment.ipv4Address.set(value.ipv4Address)
+    attachment.ipv6Address.set(value.ipv6Address)
+    attachment.links = value.links
+
+    return attachment
+}
+
+private fun networkAttachmentToNative(value: batect.dockerclient.NetworkAttachment, @Suppress("UNUSED_PARAMETER") memoryManager: MemoryManager): Pointer =
+    Struct.getMemory(ContainerNetworkAttachment(value))
+
 private fun uploadDirectoryToNative(value: batect.dockerclient.UploadDirectory, @Suppress("UNUSED_PARAMETER") memoryManager: MemoryManager): Pointer {
     val runtime = Runtime.getRuntime(nativeAPI)
     val directory = UploadDirectory(runtime)
@@ -347,6 +698,24 @@ private fun uploadFileToNative(value: batect.dockerclient.UploadFile, memoryMana
     return Struct.getMemory(file)
 }
 
+private fun uploadPathToNative(value: batect.dockerclient.UploadPath, @Suppress("UNUSED_PARAMETER") memoryManager: MemoryManager): Pointer {
+    val runtime = Runtime.getRuntime(nativeAPI)
+    val path = UploadPath(runtime)
+
+    path.localPath.set(value.localPath.toString())
+    path.containerPath.set(value.containerPath)
+    path.excludePatterns = value.excludePatterns
+    path.overrideOwner.set(value.owner != null)
+    path.owner.set(value.owner ?: 0)
+    path.group.set(value.group ?: 0)
+    path.overrideFileMode.set(value.fileMode != null)
+    path.fileMode.set(value.fileMode ?: 0)
+    path.overrideDirectoryMode.set(value.directoryMode != null)
+    path.directoryMode.set(value.directoryMode ?: 0)
+
+    return Struct.getMemory(path)
+}
+
 private class WriteOnlyList<T : Struct, E>(
     private val countProperty: KProperty1<T, Struct.u_int64_t>,
     private val pointerProperty: KProperty1<T, Struct.Pointer>,
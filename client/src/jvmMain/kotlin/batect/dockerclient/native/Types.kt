@@ -322,6 +322,8 @@ internal class PullImageReturn(runtime: Runtime) : Struct(runtime), AutoCloseabl
 
     val responsePointer = Pointer()
     val response: ImageReference? by lazy { if (responsePointer.intValue() == 0) null else ImageReference(responsePointer.get()) }
+    val allTagsImagesCount = u_int64_t()
+    val allTagsImagesPointer = Pointer()
     val errorPointer = Pointer()
     val error: Error? by lazy { if (errorPointer.intValue() == 0) null else Error(errorPointer.get()) }
 
@@ -628,12 +630,86 @@ internal class ExposedPort(runtime: Runtime) : Struct(runtime), AutoCloseable {
     val localPort = int64_t()
     val containerPort = int64_t()
     val protocol = UTF8StringRef()
+    val localIP = UTF8StringRef()
+    val localPortRangeEnd = int64_t()
+    val containerPortRangeEnd = int64_t()
 
     override fun close() {
         nativeAPI.FreeExposedPort(this)
     }
 }
 
+internal class Ulimit(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val name = UTF8StringRef()
+    val soft = int64_t()
+    val hard = int64_t()
+
+    override fun close() {
+        nativeAPI.FreeUlimit(this)
+    }
+}
+
+internal class ContainerMount(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val type = UTF8StringRef()
+    val source = UTF8StringRef()
+    val target = UTF8StringRef()
+    val readOnly = Boolean()
+    val consistency = UTF8StringRef()
+    val bindPropagation = UTF8StringRef()
+    val volumeDriver = UTF8StringRef()
+    val volumeDriverOptionsCount = u_int64_t()
+    val volumeDriverOptionsPointer = Pointer()
+    val volumeLabelsCount = u_int64_t()
+    val volumeLabelsPointer = Pointer()
+    val volumeNoCopy = Boolean()
+    val volumeSubpath = UTF8StringRef()
+    val tmpfsSizeInBytes = int64_t()
+    val tmpfsMode = int32_t()
+
+    override fun close() {
+        nativeAPI.FreeContainerMount(this)
+    }
+}
+
+internal class RestartPolicy(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val name = UTF8StringRef()
+    val maximumRetryCount = int64_t()
+
+    override fun close() {
+        nativeAPI.FreeRestartPolicy(this)
+    }
+}
+
+internal class ContainerNetworkAttachment(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val networkReference = UTF8StringRef()
+    val aliasesCount = u_int64_t()
+    val aliasesPointer = Pointer()
+    val iPv4Address = UTF8StringRef()
+    val iPv6Address = UTF8StringRef()
+    val linksCount = u_int64_t()
+    val linksPointer = Pointer()
+
+    override fun close() {
+        nativeAPI.FreeContainerNetworkAttachment(this)
+    }
+}
+
 internal class CreateContainerRequest(runtime: Runtime) : Struct(runtime), AutoCloseable {
     constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
         this.useMemory(pointer)
@@ -685,6 +761,47 @@ internal class CreateContainerRequest(runtime: Runtime) : Struct(runtime), AutoC
     val attachStdin = Boolean()
     val stdinOnce = Boolean()
     val openStdin = Boolean()
+    val memoryLimitInBytes = int64_t()
+    val memoryReservationInBytes = int64_t()
+    val memorySwapLimitInBytes = int64_t()
+    val cpuCount = Double()
+    val cpuQuota = int64_t()
+    val cpuPeriod = int64_t()
+    val cpuShares = int64_t()
+    val cpuSetCPUs = UTF8StringRef()
+    val cpuSetMemoryNodes = UTF8StringRef()
+    val pidsLimit = int64_t()
+    val ulimitsCount = u_int64_t()
+    val ulimitsPointer = Pointer()
+    val blockIOWeight = int64_t()
+    val mountsCount = u_int64_t()
+    val mountsPointer = Pointer()
+    val readOnlyRootFilesystem = Boolean()
+    val noNewPrivileges = Boolean()
+    val seccompProfile = UTF8StringRef()
+    val appArmorProfile = UTF8StringRef()
+    val seLinuxLabelsCount = u_int64_t()
+    val seLinuxLabelsPointer = Pointer()
+    val sysctlsCount = u_int64_t()
+    val sysctlsPointer = Pointer()
+    val usernsMode = UTF8StringRef()
+    val groupAddCount = u_int64_t()
+    val groupAddPointer = Pointer()
+    val oomScoreAdjustment = int64_t()
+    val restartPolicyPointer = Pointer()
+    val restartPolicy: RestartPolicy? by lazy { if (restartPolicyPointer.intValue() == 0) null else RestartPolicy(restartPolicyPointer.get()) }
+    val stopSignal = UTF8StringRef()
+    val haveStopTimeout = Boolean()
+    val stopTimeoutSeconds = int64_t()
+    val networksCount = u_int64_t()
+    val networksPointer = Pointer()
+    val autoRemove = Boolean()
+    val platform = UTF8StringRef()
+    val ipcMode = UTF8StringRef()
+    val pidMode = UTF8StringRef()
+    val utsMode = UTF8StringRef()
+    val initialConsoleHeight = int64_t()
+    val initialConsoleWidth = int64_t()
 
     override fun close() {
         nativeAPI.FreeCreateContainerRequest(this)
@@ -751,6 +868,18 @@ internal class ContainerConfig(runtime: Runtime) : Struct(runtime), AutoCloseabl
     val labelsPointer = Pointer()
     val healthcheckPointer = Pointer()
     val healthcheck: ContainerHealthcheckConfig? by lazy { if (healthcheckPointer.intValue() == 0) null else ContainerHealthcheckConfig(healthcheckPointer.get()) }
+    val stopSignal = UTF8StringRef()
+    val haveStopTimeout = Boolean()
+    val stopTimeoutSeconds = int64_t()
+    val image = UTF8StringRef()
+    val environmentVariablesCount = u_int64_t()
+    val environmentVariablesPointer = Pointer()
+    val commandCount = u_int64_t()
+    val commandPointer = Pointer()
+    val entrypointCount = u_int64_t()
+    val entrypointPointer = Pointer()
+    val workingDirectory = UTF8StringRef()
+    val user = UTF8StringRef()
 
     override fun close() {
         nativeAPI.FreeContainerConfig(this)
@@ -793,6 +922,17 @@ internal class ContainerState(runtime: Runtime) : Struct(runtime), AutoCloseable
 
     val healthPointer = Pointer()
     val health: ContainerHealthState? by lazy { if (healthPointer.intValue() == 0) null else ContainerHealthState(healthPointer.get()) }
+    val status = UTF8StringRef()
+    val running = Boolean()
+    val paused = Boolean()
+    val restarting = Boolean()
+    val oomKilled = Boolean()
+    val dead = Boolean()
+    val pid = int64_t()
+    val exitCode = int64_t()
+    val error = UTF8StringRef()
+    val startedAt = int64_t()
+    val finishedAt = int64_t()
 
     override fun close() {
         nativeAPI.FreeContainerState(this)
@@ -820,12 +960,86 @@ internal class ContainerHostConfig(runtime: Runtime) : Struct(runtime), AutoClos
 
     val logConfigPointer = Pointer()
     val logConfig: ContainerLogConfig? by lazy { if (logConfigPointer.intValue() == 0) null else ContainerLogConfig(logConfigPointer.get()) }
+    val restartPolicyPointer = Pointer()
+    val restartPolicy: RestartPolicy? by lazy { if (restartPolicyPointer.intValue() == 0) null else RestartPolicy(restartPolicyPointer.get()) }
 
     override fun close() {
         nativeAPI.FreeContainerHostConfig(this)
     }
 }
 
+internal class PortBinding(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val containerPort = int64_t()
+    val protocol = UTF8StringRef()
+    val hostIP = UTF8StringRef()
+    val hostPort = int64_t()
+
+    override fun close() {
+        nativeAPI.FreePortBinding(this)
+    }
+}
+
+internal class ContainerMountPoint(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val type = UTF8StringRef()
+    val name = UTF8StringRef()
+    val source = UTF8StringRef()
+    val destination = UTF8StringRef()
+    val driver = UTF8StringRef()
+    val mode = UTF8StringRef()
+    val readWrite = Boolean()
+    val propagation = UTF8StringRef()
+
+    override fun close() {
+        nativeAPI.FreeContainerMountPoint(this)
+    }
+}
+
+internal class ContainerNetworkEndpoint(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val networkName = UTF8StringRef()
+    val networkID = UTF8StringRef()
+    val endpointID = UTF8StringRef()
+    val aliasesCount = u_int64_t()
+    val aliasesPointer = Pointer()
+    val ipAddress = UTF8StringRef()
+    val ipPrefixLength = int64_t()
+    val gateway = UTF8StringRef()
+    val globalIPv6Address = UTF8StringRef()
+    val globalIPv6PrefixLength = int64_t()
+    val iPv6Gateway = UTF8StringRef()
+    val macAddress = UTF8StringRef()
+
+    override fun close() {
+        nativeAPI.FreeContainerNetworkEndpoint(this)
+    }
+}
+
+internal class ContainerNetworkSettings(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val portsCount = u_int64_t()
+    val portsPointer = Pointer()
+    val networksCount = u_int64_t()
+    val networksPointer = Pointer()
+
+    override fun close() {
+        nativeAPI.FreeContainerNetworkSettings(this)
+    }
+}
+
 internal class ContainerInspectionResult(runtime: Runtime) : Struct(runtime), AutoCloseable {
     constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
         this.useMemory(pointer)
@@ -839,6 +1053,13 @@ internal class ContainerInspectionResult(runtime: Runtime) : Struct(runtime), Au
     val state: ContainerState? by lazy { if (statePointer.intValue() == 0) null else ContainerState(statePointer.get()) }
     val configPointer = Pointer()
     val config: ContainerConfig? by lazy { if (configPointer.intValue() == 0) null else ContainerConfig(configPointer.get()) }
+    val networkSettingsPointer = Pointer()
+    val networkSettings: ContainerNetworkSettings? by lazy { if (networkSettingsPointer.intValue() == 0) null else ContainerNetworkSettings(networkSettingsPointer.get()) }
+    val created = int64_t()
+    val imageID = UTF8StringRef()
+    val restartCount = int64_t()
+    val mountsCount = u_int64_t()
+    val mountsPointer = Pointer()
 
     override fun close() {
         nativeAPI.FreeContainerInspectionResult(this)
@@ -993,6 +1214,8 @@ internal class CreateExecRequest(runtime: Runtime) : Struct(runtime), AutoClosea
     val workingDirectory = UTF8StringRef()
     val user = UTF8StringRef()
     val privileged = Boolean()
+    val initialConsoleHeight = int64_t()
+    val initialConsoleWidth = int64_t()
 
     override fun close() {
         nativeAPI.FreeCreateExecRequest(this)
@@ -1053,3 +1276,489 @@ internal class InspectExecReturn(runtime: Runtime) : Struct(runtime), AutoClosea
         nativeAPI.FreeInspectExecReturn(this)
     }
 }
+
+internal class ListContainersRequest(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val all = Boolean()
+    val filtersCount = u_int64_t()
+    val filtersPointer = Pointer()
+
+    override fun close() {
+        nativeAPI.FreeListContainersRequest(this)
+    }
+}
+
+internal class ContainerSummary(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val id = UTF8StringRef()
+    val namesCount = u_int64_t()
+    val namesPointer = Pointer()
+    val image = UTF8StringRef()
+    val labelsCount = u_int64_t()
+    val labelsPointer = Pointer()
+    val state = UTF8StringRef()
+    val status = UTF8StringRef()
+    val portsCount = u_int64_t()
+    val portsPointer = Pointer()
+
+    override fun close() {
+        nativeAPI.FreeContainerSummary(this)
+    }
+}
+
+internal class ListContainersReturn(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val containersCount = u_int64_t()
+    val containersPointer = Pointer()
+    val errorPointer = Pointer()
+    val error: Error? by lazy { if (errorPointer.intValue() == 0) null else Error(errorPointer.get()) }
+
+    override fun close() {
+        nativeAPI.FreeListContainersReturn(this)
+    }
+}
+
+internal class StreamContainerLogsRequest(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val containerID = UTF8StringRef()
+    val follow = Boolean()
+    val showTimestamps = Boolean()
+    val haveTailLimit = Boolean()
+    val tailLines = int64_t()
+    val haveSinceFilter = Boolean()
+    val sinceSeconds = int64_t()
+    val sinceNanoseconds = int64_t()
+    val haveUntilFilter = Boolean()
+    val untilSeconds = int64_t()
+    val untilNanoseconds = int64_t()
+
+    override fun close() {
+        nativeAPI.FreeStreamContainerLogsRequest(this)
+    }
+}
+
+internal class ContainerStatsSample(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val timestamp = int64_t()
+    val cpuPercentage = Double()
+    val memoryUsageBytes = int64_t()
+    val memoryLimitBytes = int64_t()
+    val networkReceivedBytes = int64_t()
+    val networkTransmittedBytes = int64_t()
+    val networkReceivedBytesDelta = int64_t()
+    val networkTransmittedBytesDelta = int64_t()
+    val blockIOReadBytes = int64_t()
+    val blockIOWrittenBytes = int64_t()
+    val blockIOReadBytesDelta = int64_t()
+    val blockIOWrittenBytesDelta = int64_t()
+
+    override fun close() {
+        nativeAPI.FreeContainerStatsSample(this)
+    }
+}
+
+internal interface ContainerStatsCallback {
+    @Delegate
+    fun invoke(userData: Pointer?, statsPointer: Pointer?): Boolean
+}
+
+internal class UploadPath(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val localPath = UTF8StringRef()
+    val containerPath = UTF8StringRef()
+    val excludePatternsCount = u_int64_t()
+    val excludePatternsPointer = Pointer()
+    val overrideOwner = Boolean()
+    val owner = int32_t()
+    val group = int32_t()
+    val overrideFileMode = Boolean()
+    val fileMode = int32_t()
+    val overrideDirectoryMode = Boolean()
+    val directoryMode = int32_t()
+
+    override fun close() {
+        nativeAPI.FreeUploadPath(this)
+    }
+}
+
+internal class UploadPathsToContainerRequest(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val pathsCount = u_int64_t()
+    val pathsPointer = Pointer()
+    val followSymlinks = Boolean()
+
+    override fun close() {
+        nativeAPI.FreeUploadPathsToContainerRequest(this)
+    }
+}
+
+internal class WaitForContainerToBeHealthyReturn(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val outcome = UTF8StringRef()
+    val lastHealthcheckOutput = UTF8StringRef()
+    val exitCode = int64_t()
+    val errorPointer = Pointer()
+    val error: Error? by lazy { if (errorPointer.intValue() == 0) null else Error(errorPointer.get()) }
+
+    override fun close() {
+        nativeAPI.FreeWaitForContainerToBeHealthyReturn(this)
+    }
+}
+
+internal class UpdateContainerRequest(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val memoryLimitInBytes = int64_t()
+    val memoryReservationInBytes = int64_t()
+    val memorySwapLimitInBytes = int64_t()
+    val cpuCount = Double()
+    val cpuQuota = int64_t()
+    val cpuPeriod = int64_t()
+    val cpuShares = int64_t()
+    val cpuSetCPUs = UTF8StringRef()
+    val cpuSetMemoryNodes = UTF8StringRef()
+    val pidsLimit = int64_t()
+    val blockIOWeight = int64_t()
+    val restartPolicyPointer = Pointer()
+    val restartPolicy: RestartPolicy? by lazy { if (restartPolicyPointer.intValue() == 0) null else RestartPolicy(restartPolicyPointer.get()) }
+
+    override fun close() {
+        nativeAPI.FreeUpdateContainerRequest(this)
+    }
+}
+
+internal class CommitContainerRequest(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val containerID = UTF8StringRef()
+    val reference = UTF8StringRef()
+    val author = UTF8StringRef()
+    val message = UTF8StringRef()
+    val changesCount = u_int64_t()
+    val changesPointer = Pointer()
+    val labelsCount = u_int64_t()
+    val labelsPointer = Pointer()
+    val pause = Boolean()
+
+    override fun close() {
+        nativeAPI.FreeCommitContainerRequest(this)
+    }
+}
+
+internal class CommitContainerReturn(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val responsePointer = Pointer()
+    val response: ImageReference? by lazy { if (responsePointer.intValue() == 0) null else ImageReference(responsePointer.get()) }
+    val errorPointer = Pointer()
+    val error: Error? by lazy { if (errorPointer.intValue() == 0) null else Error(errorPointer.get()) }
+
+    override fun close() {
+        nativeAPI.FreeCommitContainerReturn(this)
+    }
+}
+
+internal class ContainerFilesystemChange(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val path = UTF8StringRef()
+    val kind = UTF8StringRef()
+
+    override fun close() {
+        nativeAPI.FreeContainerFilesystemChange(this)
+    }
+}
+
+internal class ContainerChangesReturn(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val changesCount = u_int64_t()
+    val changesPointer = Pointer()
+    val errorPointer = Pointer()
+    val error: Error? by lazy { if (errorPointer.intValue() == 0) null else Error(errorPointer.get()) }
+
+    override fun close() {
+        nativeAPI.FreeContainerChangesReturn(this)
+    }
+}
+
+internal class ContainerProcess(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val valuesCount = u_int64_t()
+    val valuesPointer = Pointer()
+
+    override fun close() {
+        nativeAPI.FreeContainerProcess(this)
+    }
+}
+
+internal class ContainerTopResult(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val titlesCount = u_int64_t()
+    val titlesPointer = Pointer()
+    val processesCount = u_int64_t()
+    val processesPointer = Pointer()
+
+    override fun close() {
+        nativeAPI.FreeContainerTopResult(this)
+    }
+}
+
+internal class ContainerTopReturn(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val responsePointer = Pointer()
+    val response: ContainerTopResult? by lazy { if (responsePointer.intValue() == 0) null else ContainerTopResult(responsePointer.get()) }
+    val errorPointer = Pointer()
+    val error: Error? by lazy { if (errorPointer.intValue() == 0) null else Error(errorPointer.get()) }
+
+    override fun close() {
+        nativeAPI.FreeContainerTopReturn(this)
+    }
+}
+
+internal class PruneContainersRequest(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val filtersCount = u_int64_t()
+    val filtersPointer = Pointer()
+
+    override fun close() {
+        nativeAPI.FreePruneContainersRequest(this)
+    }
+}
+
+internal class PruneContainersResult(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val containerIDsCount = u_int64_t()
+    val containerIDsPointer = Pointer()
+    val spaceReclaimedInBytes = int64_t()
+
+    override fun close() {
+        nativeAPI.FreePruneContainersResult(this)
+    }
+}
+
+internal class PruneContainersReturn(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val responsePointer = Pointer()
+    val response: PruneContainersResult? by lazy { if (responsePointer.intValue() == 0) null else PruneContainersResult(responsePointer.get()) }
+    val errorPointer = Pointer()
+    val error: Error? by lazy { if (errorPointer.intValue() == 0) null else Error(errorPointer.get()) }
+
+    override fun close() {
+        nativeAPI.FreePruneContainersReturn(this)
+    }
+}
+
+internal class ImageConfig(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val environmentVariablesCount = u_int64_t()
+    val environmentVariablesPointer = Pointer()
+    val entrypointCount = u_int64_t()
+    val entrypointPointer = Pointer()
+    val commandCount = u_int64_t()
+    val commandPointer = Pointer()
+    val workingDirectory = UTF8StringRef()
+    val user = UTF8StringRef()
+    val exposedPortsCount = u_int64_t()
+    val exposedPortsPointer = Pointer()
+    val volumesCount = u_int64_t()
+    val volumesPointer = Pointer()
+    val labelsCount = u_int64_t()
+    val labelsPointer = Pointer()
+    val healthcheckPointer = Pointer()
+    val healthcheck: ContainerHealthcheckConfig? by lazy { if (healthcheckPointer.intValue() == 0) null else ContainerHealthcheckConfig(healthcheckPointer.get()) }
+    val stopSignal = UTF8StringRef()
+
+    override fun close() {
+        nativeAPI.FreeImageConfig(this)
+    }
+}
+
+internal class ImageInspectionResult(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val id = UTF8StringRef()
+    val repoTagsCount = u_int64_t()
+    val repoTagsPointer = Pointer()
+    val repoDigestsCount = u_int64_t()
+    val repoDigestsPointer = Pointer()
+    val created = int64_t()
+    val sizeInBytes = int64_t()
+    val os = UTF8StringRef()
+    val architecture = UTF8StringRef()
+    val variant = UTF8StringRef()
+    val author = UTF8StringRef()
+    val configPointer = Pointer()
+    val config: ImageConfig? by lazy { if (configPointer.intValue() == 0) null else ImageConfig(configPointer.get()) }
+    val rootFSType = UTF8StringRef()
+    val rootFSLayersCount = u_int64_t()
+    val rootFSLayersPointer = Pointer()
+
+    override fun close() {
+        nativeAPI.FreeImageInspectionResult(this)
+    }
+}
+
+internal class InspectImageReturn(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val responsePointer = Pointer()
+    val response: ImageInspectionResult? by lazy { if (responsePointer.intValue() == 0) null else ImageInspectionResult(responsePointer.get()) }
+    val errorPointer = Pointer()
+    val error: Error? by lazy { if (errorPointer.intValue() == 0) null else Error(errorPointer.get()) }
+
+    override fun close() {
+        nativeAPI.FreeInspectImageReturn(this)
+    }
+}
+
+internal class ListImagesRequest(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val all = Boolean()
+    val filtersCount = u_int64_t()
+    val filtersPointer = Pointer()
+
+    override fun close() {
+        nativeAPI.FreeListImagesRequest(this)
+    }
+}
+
+internal class ImageSummary(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val id = UTF8StringRef()
+    val parentID = UTF8StringRef()
+    val repoTagsCount = u_int64_t()
+    val repoTagsPointer = Pointer()
+    val repoDigestsCount = u_int64_t()
+    val repoDigestsPointer = Pointer()
+    val sizeInBytes = int64_t()
+    val created = int64_t()
+    val labelsCount = u_int64_t()
+    val labelsPointer = Pointer()
+
+    override fun close() {
+        nativeAPI.FreeImageSummary(this)
+    }
+}
+
+internal class ListImagesReturn(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val imagesCount = u_int64_t()
+    val imagesPointer = Pointer()
+    val errorPointer = Pointer()
+    val error: Error? by lazy { if (errorPointer.intValue() == 0) null else Error(errorPointer.get()) }
+
+    override fun close() {
+        nativeAPI.FreeListImagesReturn(this)
+    }
+}
+
+internal class PushImageReturn(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val digest = UTF8StringRef()
+    val errorPointer = Pointer()
+    val error: Error? by lazy { if (errorPointer.intValue() == 0) null else Error(errorPointer.get()) }
+
+    override fun close() {
+        nativeAPI.FreePushImageReturn(this)
+    }
+}
+
+internal class SaveImagesRequest(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val referencesCount = u_int64_t()
+    val referencesPointer = Pointer()
+
+    override fun close() {
+        nativeAPI.FreeSaveImagesRequest(this)
+    }
+}
+
+internal class LoadImagesReturn(runtime: Runtime) : Struct(runtime), AutoCloseable {
+    constructor(pointer: jnr.ffi.Pointer) : this(pointer.runtime) {
+        this.useMemory(pointer)
+    }
+
+    val referencesCount = u_int64_t()
+    val referencesPointer = Pointer()
+    val errorPointer = Pointer()
+    val error: Error? by lazy { if (errorPointer.intValue() == 0) null else Error(errorPointer.get()) }
+
+    override fun close() {
+        nativeAPI.FreeLoadImagesReturn(this)
+    }
+}
@@ -28,14 +28,23 @@ import batect.dockerclient.native.BuildImageProgressUpdate_StepPullProgressUpdat
 import batect.dockerclient.native.BuildImageProgressUpdate_StepStarting
 import batect.dockerclient.native.BuildImageRequest
 import batect.dockerclient.native.ClientConfiguration
+import batect.dockerclient.native.CommitContainerRequest
+import batect.dockerclient.native.ContainerNetworkAttachment
 import batect.dockerclient.native.CreateContainerRequest
 import batect.dockerclient.native.CreateExecRequest
+import batect.dockerclient.native.ListContainersRequest
+import batect.dockerclient.native.ListImagesRequest
+import batect.dockerclient.native.PruneContainersRequest
 import batect.dockerclient.native.PullImageProgressDetail
 import batect.dockerclient.native.PullImageProgressUpdate
+import batect.dockerclient.native.SaveImagesRequest
+import batect.dockerclient.native.StreamContainerLogsRequest
 import batect.dockerclient.native.StreamEventsRequest
 import batect.dockerclient.native.StringPair
 import batect.dockerclient.native.StringToStringListPair
 import batect.dockerclient.native.TLSConfiguration
+import batect.dockerclient.native.UpdateContainerRequest
+import batect.dockerclient.native.UploadPathsToContainerRequest
 import batect.dockerclient.native.UploadToContainerRequest
 import kotlinx.cinterop.CPointed
 import kotlinx.cinterop.CPointer
@@ -55,6 +64,7 @@ import kotlinx.datetime.Instant
 import okio.Path
 import okio.Path.Companion.toPath
 import kotlin.time.Duration.Companion.nanoseconds
+import kotlin.time.Duration.Companion.seconds
 
 internal fun DockerClientConfiguration(native: batect.dockerclient.native.ClientConfiguration): DockerClientConfiguration {
     val configDirectory = native.ConfigDirectoryPath!!.toKString()
@@ -147,10 +157,66 @@ internal fun ContainerInspectionResult(native: batect.dockerclient.native.Contai
     ContainerHostConfig(native.HostConfig!!.pointed),
     ContainerState(native.State!!.pointed),
     ContainerConfig(native.Config!!.pointed),
+    ContainerNetworkSettings(native.NetworkSettings!!.pointed),
+    Instant.fromEpochMilliseconds(native.Created),
+    native.ImageID!!.toKString(),
+    native.RestartCount,
+    fromArray(native.Mounts!!, native.MountsCount) { ContainerMountPoint(it) },
 )
 
+internal fun ContainerNetworkSettings(native: batect.dockerclient.native.ContainerNetworkSettings): ContainerNetworkSettings =
+    ContainerNetworkSettings(
+        fromArray(native.Ports!!, native.PortsCount) { PortBinding(it) },
+        fromArray(native.Networks!!, native.NetworksCount) { ContainerNetworkEndpoint(it) },
+    )
+
+internal fun ContainerNetworkEndpoint(native: batect.dockerclient.native.ContainerNetworkEndpoint): ContainerNetworkEndpoint =
+    ContainerNetworkEndpoint(
+        native.NetworkName!!.toKString(),
+        native.NetworkID!!.toKString(),
+        native.EndpointID!!.toKString(),
+        fromArray(native.Aliases!!, native.AliasesCount) { it.ptr.toKString() },
+        native.IPAddress!!.toKString(),
+        native.IPPrefixLength,
+        native.Gateway!!.toKString(),
+        native.GlobalIPv6Address!!.toKString(),
+        native.GlobalIPv6PrefixLength,
+        native.IPv6Gateway!!.toKString(),
+        native.MacAddress!!.toKString(),
+    )
+
+internal fun ContainerMountPoint(native: batect.dockerclient.native.ContainerMountPoint): ContainerMountPoint =
+    ContainerMountPoint(
+        native.Type!!.toKString(),
+        native.Name!!.toKString(),
+        native.Source!!.toKString(),
+        native.Destination!!.toKString(),
+        native.Driver!!.toKString(),
+        native.Mode!!.toKString(),
+        native.ReadWrite,
+        native.Propagation!!.toKString(),
+    )
+
 internal fun ContainerHostConfig(native: batect.dockerclient.native.ContainerHostConfig): ContainerHostConfig =
-    ContainerHostConfig(ContainerLogConfig(native.LogConfig!!.pointed))
+    ContainerHostConfig(
+        ContainerLogConfig(native.LogConfig!!.pointed),
+        RestartPolicy(native.RestartPolicy!!.pointed),
+    )
+
+internal fun RestartPolicy(native: batect.dockerclient.native.RestartPolicy): RestartPolicy =
+    RestartPolicy(native.Name!!.toKString(), native.MaximumRetryCount)
+
+internal fun ContainerFilesystemChange(native: batect.dockerclient.native.ContainerFilesystemChange): ContainerFilesystemChange =
+    ContainerFilesystemChange(native.Path!!.toKString(), native.Kind!!.toKString())
+
+internal fun ContainerProcessList(native: batect.dockerclient.native.ContainerTopResult): ContainerProcessList =
+    ContainerProcessList(
+        fromArray(native.Titles!!, native.TitlesCount) { it.ptr.toKString() },
+        fromArray(native.Processes!!, native.ProcessesCount) { ContainerProcess(it) },
+    )
+
+internal fun ContainerProcess(native: batect.dockerclient.native.ContainerProcess): ContainerProcess =
+    ContainerProcess(fromArray(native.Values!!, native.ValuesCount) { it.ptr.toKString() })
 
 internal fun ContainerLogConfig(native: batect.dockerclient.native.ContainerLogConfig): ContainerLogConfig =
     ContainerLogConfig(
@@ -161,8 +227,22 @@ internal fun ContainerLogConfig(native: batect.dockerclient.native.ContainerLogC
 internal fun ContainerState(native: batect.dockerclient.native.ContainerState): ContainerState =
     ContainerState(
         if (native.Health == null) null else ContainerHealthState(native.Health!!.pointed),
+        native.Status!!.toKString(),
+        native.Running,
+        native.Paused,
+        native.Restarting,
+        native.OOMKilled,
+        native.Dead,
+        native.PID,
+        native.ExitCode,
+        native.Error!!.toKString(),
+        instantOrNull(native.StartedAt),
+        instantOrNull(native.FinishedAt),
     )
 
+private fun instantOrNull(epochMilliseconds: Long): Instant? =
+    if (epochMilliseconds == 0L) null else Instant.fromEpochMilliseconds(epochMilliseconds)
+
 internal fun ContainerHealthState(native: batect.dockerclient.native.ContainerHealthState): ContainerHealthState =
     ContainerHealthState(
         native.Status!!.toKString(),
@@ -181,6 +261,14 @@ internal fun ContainerConfig(native: batect.dockerclient.native.ContainerConfig)
     ContainerConfig(
         mapFromStringPairs(native.Labels!!, native.LabelsCount),
         if (native.Healthcheck == null) null else ContainerHealthcheckConfig(native.Healthcheck!!.pointed),
+        native.StopSignal!!.toKString().ifEmpty { null },
+        if (native.HaveStopTimeout) native.StopTimeoutSeconds.seconds else null,
+        native.Image!!.toKString(),
+        environmentVariablesToMap(fromArray(native.EnvironmentVariables!!, native.EnvironmentVariablesCount) { it.ptr.toKString() }),
+        fromArray(native.Command!!, native.CommandCount) { it.ptr.toKString() },
+        fromArray(native.Entrypoint!!, native.EntrypointCount) { it.ptr.toKString() },
+        native.WorkingDirectory!!.toKString(),
+        native.User!!.toKString(),
     )
 
 internal fun ContainerHealthcheckConfig(native: batect.dockerclient.native.ContainerHealthcheckConfig): ContainerHealthcheckConfig =
@@ -192,6 +280,56 @@ internal fun ContainerHealthcheckConfig(native: batect.dockerclient.native.Conta
         native.Retries.toInt(),
     )
 
+internal fun ImageInspectionResult(native: batect.dockerclient.native.ImageInspectionResult): ImageInspectionResult =
+    ImageInspectionResult(
+        ImageReference(native.ID!!.toKString()),
+        fromArray(native.RepoTags!!, native.RepoTagsCount) { it.ptr.toKString() },
+        fromArray(native.RepoDigests!!, native.RepoDigestsCount) { it.ptr.toKString() },
+        Instant.fromEpochMilliseconds(native.Created),
+        native.SizeInBytes,
+        native.OS!!.toKString(),
+        native.Architecture!!.toKString(),
+        native.Variant!!.toKString().ifEmpty { null },
+        native.Author!!.toKString(),
+        ImageConfig(native.Config!!.pointed),
+        ImageRootFS(native.RootFSType!!.toKString(), fromArray(native.RootFSLayers!!, native.RootFSLayersCount) { it.ptr.toKString() }),
+    )
+
+internal fun ImageConfig(native: batect.dockerclient.native.ImageConfig): ImageConfig =
+    ImageConfig(
+        environmentVariablesToMap(fromArray(native.EnvironmentVariables!!, native.EnvironmentVariablesCount) { it.ptr.toKString() }),
+        fromArray(native.Entrypoint!!, native.EntrypointCount) { it.ptr.toKString() },
+        fromArray(native.Command!!, native.CommandCount) { it.ptr.toKString() },
+        native.WorkingDirectory!!.toKString(),
+        native.User!!.toKString(),
+        fromArray(native.ExposedPorts!!, native.ExposedPortsCount) { it.ptr.toKString() },
+        fromArray(native.Volumes!!, native.VolumesCount) { it.ptr.toKString() },
+        mapFromStringPairs(native.Labels!!, native.LabelsCount),
+        if (native.Healthcheck == null) null else ContainerHealthcheckConfig(native.Healthcheck!!.pointed),
+        native.StopSignal!!.toKString().ifEmpty { null },
+    )
+
+internal fun MemScope.allocListImagesRequest(all: Boolean, filters: Map<String, Set<String>>): ListImagesRequest = alloc<ListImagesRequest> {
+    All = all
+    Filters = allocFilters(filters)
+    FiltersCount = filters.size.toULong()
+}
+
+internal fun MemScope.allocSaveImagesRequest(names: Set<String>): SaveImagesRequest = alloc<SaveImagesRequest> {
+    References = allocArrayOfPointersTo(names.toList())
+    ReferencesCount = names.size.toULong()
+}
+
+internal fun ImageSummary(native: batect.dockerclient.native.ImageSummary): ImageSummary = ImageSummary(
+    ImageReference(native.ID!!.toKString()),
+    native.ParentID!!.toKString().ifEmpty { null },
+    fromArray(native.RepoTags!!, native.RepoTagsCount) { it.ptr.toKString() },
+    fromArray(native.RepoDigests!!, native.RepoDigestsCount) { it.ptr.toKString() },
+    native.SizeInBytes,
+    Instant.fromEpochMilliseconds(native.Created),
+    mapFromStringPairs(native.Labels!!, native.LabelsCount),
+)
+
 internal fun Event(native: batect.dockerclient.native.Event): Event =
     Event(
         native.Type!!.toKString(),
@@ -356,6 +494,132 @@ internal fun MemScope.allocCreateContainerRequest(spec: ContainerCreationSpec):
         AttachStdin = spec.attachStdin
         StdinOnce = spec.stdinOnce
         OpenStdin = spec.openStdin
+        MemoryLimitInBytes = spec.memoryLimitInBytes ?: 0
+        MemoryReservationInBytes = spec.memoryReservationInBytes ?: 0
+        MemorySwapLimitInBytes = spec.memorySwapLimitInBytes ?: 0
+        CPUCount = spec.cpuCount ?: 0.0
+        CPUQuota = spec.cpuQuotaInMicroseconds ?: 0
+        CPUPeriod = spec.cpuPeriodInMicroseconds ?: 0
+        CPUShares = spec.cpuShares ?: 0
+        CPUSetCPUs = spec.cpuSetCPUs?.cstr?.ptr
+        CPUSetMemoryNodes = spec.cpuSetMemoryNodes?.cstr?.ptr
+        PidsLimit = spec.pidsLimit ?: 0
+        Ulimits = allocArrayOfPointersTo(spec.ulimits.map { allocUlimit(it) })
+        UlimitsCount = spec.ulimits.size.toULong()
+        BlockIOWeight = spec.blockIOWeight?.toLong() ?: 0
+        Mounts = allocArrayOfPointersTo(spec.mounts.map { allocContainerMount(it) })
+        MountsCount = spec.mounts.size.toULong()
+        ReadOnlyRootFilesystem = spec.readOnlyRootFilesystem
+        NoNewPrivileges = spec.noNewPrivileges
+        SeccompProfile = spec.seccompProfile?.cstr?.ptr
+        AppArmorProfile = spec.appArmorProfile?.cstr?.ptr
+        SELinuxLabels = allocArrayOfPointersTo(spec.seLinuxLabels)
+        SELinuxLabelsCount = spec.seLinuxLabels.size.toULong()
+        Sysctls = allocArrayOfPointersTo(spec.sysctls.map { allocStringPair(it) })
+        SysctlsCount = spec.sysctls.size.toULong()
+        UsernsMode = spec.usernsMode?.cstr?.ptr
+        GroupAdd = allocArrayOfPointersTo(spec.additionalGroups)
+        GroupAddCount = spec.additionalGroups.size.toULong()
+        OOMScoreAdjustment = spec.oomScoreAdjustment?.toLong() ?: 0
+        RestartPolicy = spec.restartPolicy?.let { allocRestartPolicy(it).ptr }
+        StopSignal = spec.stopSignal?.cstr?.ptr
+        HaveStopTimeout = spec.stopTimeout != null
+        StopTimeoutSeconds = spec.stopTimeout?.inWholeSeconds ?: 0
+        Networks = allocArrayOfPointersTo(spec.networkAttachments.map { allocContainerNetworkAttachment(it) })
+        NetworksCount = spec.networkAttachments.size.toULong()
+        AutoRemove = spec.autoRemove
+        Platform = spec.platform?.cstr?.ptr
+        IPCMode = spec.ipcMode?.cstr?.ptr
+        PIDMode = spec.pidMode?.cstr?.ptr
+        UTSMode = spec.utsMode?.cstr?.ptr
+        InitialConsoleHeight = spec.initialConsoleHeight ?: 0
+        InitialConsoleWidth = spec.initialConsoleWidth ?: 0
+    }
+}
+
+internal fun MemScope.allocUlimit(ulimit: Ulimit): batect.dockerclient.native.Ulimit {
+    return alloc<batect.dockerclient.native.Ulimit> {
+        Name = ulimit.name.cstr.ptr
+        Soft = ulimit.soft
+        Hard = ulimit.hard
+    }
+}
+
+internal fun MemScope.allocContainerMount(mount: Mount): batect.dockerclient.native.ContainerMount {
+    return alloc<batect.dockerclient.native.ContainerMount> {
+        Target = mount.containerPath.cstr.ptr
+        ReadOnly = mount.readOnly
+        Consistency = mount.consistency?.cstr?.ptr
+
+        when (mount) {
+            is BindTypeMount -> {
+                Type = "bind".cstr.ptr
+                Source = mount.localPath.toString().cstr.ptr
+                BindPropagation = mount.propagation?.cstr?.ptr
+            }
+            is VolumeTypeMount -> {
+                Type = "volume".cstr.ptr
+                Source = mount.volume?.name?.cstr?.ptr
+                VolumeNoCopy = mount.noCopy
+                VolumeSubpath = mount.subpath?.cstr?.ptr
+                VolumeDriver = mount.driver?.cstr?.ptr
+                VolumeDriverOptions = allocArrayOfPointersTo(mount.driverOptions.map { allocStringPair(it) })
+                VolumeDriverOptionsCount = mount.driverOptions.size.toULong()
+                VolumeLabels = allocArrayOfPointersTo(mount.labels.map { allocStringPair(it) })
+                VolumeLabelsCount = mount.labels.size.toULong()
+            }
+            is TmpfsTypeMount -> {
+                Type = "tmpfs".cstr.ptr
+                TmpfsSizeInBytes = mount.sizeInBytes ?: 0
+                TmpfsMode = mount.mode ?: 0
+            }
+        }
+    }
+}
+
+internal fun MemScope.allocUpdateContainerRequest(spec: ContainerUpdateSpec): UpdateContainerRequest = alloc<UpdateContainerRequest> {
+    MemoryLimitInBytes = spec.memoryLimitInBytes ?: 0
+    MemoryReservationInBytes = spec.memoryReservationInBytes ?: 0
+    MemorySwapLimitInBytes = spec.memorySwapLimitInBytes ?: 0
+    CPUCount = spec.cpuCount ?: 0.0
+    CPUQuota = spec.cpuQuotaInMicroseconds ?: 0
+    CPUPeriod = spec.cpuPeriodInMicroseconds ?: 0
+    CPUShares = spec.cpuShares ?: 0
+    CPUSetCPUs = spec.cpuSetCPUs?.cstr?.ptr
+    CPUSetMemoryNodes = spec.cpuSetMemoryNodes?.cstr?.ptr
+    PidsLimit = spec.pidsLimit ?: 0
+    BlockIOWeight = spec.blockIOWeight?.toLong() ?: 0
+    RestartPolicy = spec.restartPolicy?.let { allocRestartPolicy(it).ptr }
+}
+
+internal fun MemScope.allocCommitContainerRequest(spec: ContainerCommitSpec): CommitContainerRequest = alloc<CommitContainerRequest> {
+    ContainerID = spec.container.id.cstr.ptr
+    Reference = spec.reference?.cstr?.ptr
+    Author = spec.author?.cstr?.ptr
+    Message = spec.message?.cstr?.ptr
+    Changes = allocArrayOfPointersTo(spec.changes)
+    ChangesCount = spec.changes.size.toULong()
+    Labels = allocArrayOfPointersTo(spec.labels.map { allocStringPair(it) })
+    LabelsCount = spec.labels.size.toULong()
+    Pause = spec.pause
+}
+
+internal fun MemScope.allocRestartPolicy(policy: RestartPolicy): batect.dockerclient.native.RestartPolicy {
+    return alloc<batect.dockerclient.native.RestartPolicy> {
+        Name = policy.name.cstr.ptr
+        MaximumRetryCount = policy.maximumRetryCount
+    }
+}
+
+internal fun MemScope.allocContainerNetworkAttachment(attachment: NetworkAttachment): ContainerNetworkAttachment {
+    return alloc<ContainerNetworkAttachment> {
+        NetworkReference = attachment.network.id.cstr.ptr
+        Aliases = allocArrayOfPointersTo(attachment.aliases)
+        AliasesCount = attachment.aliases.size.toULong()
+        IPv4Address = attachment.ipv4Address?.cstr?.ptr
+        IPv6Address = attachment.ipv6Address?.cstr?.ptr
+        Links = allocArrayOfPointersTo(attachment.links)
+        LinksCount = attachment.links.size.toULong()
     }
 }
 
@@ -379,6 +643,9 @@ internal fun MemScope.allocExposedPort(port: ExposedPort): batect.dockerclient.n
         LocalPort = port.localPort
         ContainerPort = port.containerPort
         Protocol = port.protocol.cstr.ptr
+        LocalIP = port.localIP?.cstr?.ptr
+        LocalPortRangeEnd = port.localPortRangeEnd ?: 0
+        ContainerPortRangeEnd = port.containerPortRangeEnd ?: 0
     }
 }
 
@@ -414,6 +681,30 @@ internal fun MemScope.allocUploadFile(file: UploadFile): batect.dockerclient.nat
     }
 }
 
+internal fun MemScope.allocUploadPathsToContainerRequest(paths: Set<UploadPath>, followSymlinks: Boolean): UploadPathsToContainerRequest {
+    return alloc<UploadPathsToContainerRequest> {
+        Paths = allocArrayOfPointersTo(paths.map { allocUploadPath(it) })
+        PathsCount = paths.size.toULong()
+        FollowSymlinks = followSymlinks
+    }
+}
+
+internal fun MemScope.allocUploadPath(path: UploadPath): batect.dockerclient.native.UploadPath {
+    return alloc<batect.dockerclient.native.UploadPath> {
+        LocalPath = path.localPath.toString().cstr.ptr
+        ContainerPath = path.containerPath.cstr.ptr
+        ExcludePatterns = allocArrayOfPointersTo(path.excludePatterns)
+        ExcludePatternsCount = path.excludePatterns.size.toULong()
+        OverrideOwner = path.owner != null
+        Owner = path.owner ?: 0
+        Group = path.group ?: 0
+        OverrideFileMode = path.fileMode != null
+        FileMode = path.fileMode ?: 0
+        OverrideDirectoryMode = path.directoryMode != null
+        DirectoryMode = path.directoryMode ?: 0
+    }
+}
+
 internal fun MemScope.allocFilters(filters: Map<String, Set<String>>) = allocArrayOfPointersTo(filters.map { (key, value) -> allocStringToStringListPair(key, value) })
 
 internal fun MemScope.allocStringToStringListPair(key: String, values: Set<String>) = alloc<StringToStringListPair> {
@@ -433,6 +724,75 @@ internal fun MemScope.allocStreamEventsRequest(since: Instant?, until: Instant?,
     FiltersCount = filters.size.toULong()
 }
 
+internal fun MemScope.allocStreamContainerLogsRequest(
+    container: ContainerReference,
+    follow: Boolean,
+    showTimestamps: Boolean,
+    tailLines: Long?,
+    since: Instant?,
+    until: Instant?,
+): StreamContainerLogsRequest = alloc<StreamContainerLogsRequest> {
+    ContainerID = container.id.cstr.ptr
+    Follow = follow
+    ShowTimestamps = showTimestamps
+    HaveTailLimit = tailLines != null
+    TailLines = tailLines ?: 0
+    HaveSinceFilter = since != null
+    SinceSeconds = since?.epochSeconds ?: 0
+    SinceNanoseconds = since?.nanosecondsOfSecond?.toLong() ?: 0
+    HaveUntilFilter = until != null
+    UntilSeconds = until?.epochSeconds ?: 0
+    UntilNanoseconds = until?.nanosecondsOfSecond?.toLong() ?: 0
+}
+
+internal fun ContainerStatsSample(native: batect.dockerclient.native.ContainerStatsSample): ContainerStatsSample = ContainerStatsSample(
+    Instant.fromEpochMilliseconds(native.Timestamp),
+    native.CPUPercentage,
+    native.MemoryUsageBytes,
+    native.MemoryLimitBytes,
+    native.NetworkReceivedBytes,
+    native.NetworkTransmittedBytes,
+    native.NetworkReceivedBytesDelta,
+    native.NetworkTransmittedBytesDelta,
+    native.BlockIOReadBytes,
+    native.BlockIOWrittenBytes,
+    native.BlockIOReadBytesDelta,
+    native.BlockIOWrittenBytesDelta,
+)
+
+internal fun MemScope.allocListContainersRequest(all: Boolean, filters: Map<String, Set<String>>): ListContainersRequest = alloc<ListContainersRequest> {
+    All = all
+    Filters = allocFilters(filters)
+    FiltersCount = filters.size.toULong()
+}
+
+internal fun MemScope.allocPruneContainersRequest(filters: Map<String, Set<String>>): PruneContainersRequest = alloc<PruneContainersRequest> {
+    Filters = allocFilters(filters)
+    FiltersCount = filters.size.toULong()
+}
+
+internal fun ContainerPruneResult(native: batect.dockerclient.native.PruneContainersResult): ContainerPruneResult = ContainerPruneResult(
+    fromArray(native.ContainerIDs!!, native.ContainerIDsCount) { ContainerReference(it.ptr.toKString()) },
+    native.SpaceReclaimedInBytes,
+)
+
+internal fun ContainerSummary(native: batect.dockerclient.native.ContainerSummary): ContainerSummary = ContainerSummary(
+    ContainerReference(native.ID!!.toKString()),
+    fromArray(native.Names!!, native.NamesCount) { it.ptr.toKString() },
+    native.Image!!.toKString(),
+    mapFromStringPairs(native.Labels!!, native.LabelsCount),
+    native.State!!.toKString(),
+    native.Status!!.toKString(),
+    fromArray(native.Ports!!, native.PortsCount) { PortBinding(it) },
+)
+
+internal fun PortBinding(native: batect.dockerclient.native.PortBinding): PortBinding = PortBinding(
+    native.ContainerPort,
+    native.Protocol!!.toKString(),
+    native.HostIP!!.toKString(),
+    native.HostPort,
+)
+
 internal fun MemScope.allocCreateExecRequest(spec: ContainerExecSpec): CreateExecRequest = alloc<CreateExecRequest> {
     ContainerID = spec.container.id.cstr.ptr
     Command = allocArrayOfPointersTo(spec.command)
@@ -446,6 +806,8 @@ internal fun MemScope.allocCreateExecRequest(spec: ContainerExecSpec): CreateExe
     WorkingDirectory = spec.workingDirectory?.cstr?.ptr
     User = spec.userAndGroupFormattedForDocker?.cstr?.ptr
     Privileged = spec.privileged
+    InitialConsoleHeight = spec.initialConsoleHeight ?: 0
+    InitialConsoleWidth = spec.initialConsoleWidth ?: 0
 }
 
 internal fun ContainerExecInspectionResult(native: batect.dockerclient.native.InspectExecResult): ContainerExecInspectionResult = ContainerExecInspectionResult(
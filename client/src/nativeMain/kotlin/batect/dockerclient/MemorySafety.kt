@@ -19,6 +19,9 @@
 package batect.dockerclient
 
 import batect.dockerclient.native.BuildImageReturn
+import batect.dockerclient.native.CommitContainerReturn
+import batect.dockerclient.native.ContainerChangesReturn
+import batect.dockerclient.native.ContainerTopReturn
 import batect.dockerclient.native.CreateClientReturn
 import batect.dockerclient.native.CreateContainerReturn
 import batect.dockerclient.native.CreateExecReturn
@@ -27,6 +30,9 @@ import batect.dockerclient.native.CreateVolumeReturn
 import batect.dockerclient.native.DetermineCLIContextReturn
 import batect.dockerclient.native.Error
 import batect.dockerclient.native.FreeBuildImageReturn
+import batect.dockerclient.native.FreeCommitContainerReturn
+import batect.dockerclient.native.FreeContainerChangesReturn
+import batect.dockerclient.native.FreeContainerTopReturn
 import batect.dockerclient.native.FreeCreateClientReturn
 import batect.dockerclient.native.FreeCreateContainerReturn
 import batect.dockerclient.native.FreeCreateExecReturn
@@ -39,20 +45,34 @@ import batect.dockerclient.native.FreeGetImageReturn
 import batect.dockerclient.native.FreeGetNetworkByNameOrIDReturn
 import batect.dockerclient.native.FreeInspectContainerReturn
 import batect.dockerclient.native.FreeInspectExecReturn
+import batect.dockerclient.native.FreeInspectImageReturn
 import batect.dockerclient.native.FreeListAllVolumesReturn
+import batect.dockerclient.native.FreeListContainersReturn
+import batect.dockerclient.native.FreeListImagesReturn
 import batect.dockerclient.native.FreeLoadClientConfigurationFromCLIContextReturn
+import batect.dockerclient.native.FreeLoadImagesReturn
 import batect.dockerclient.native.FreePingReturn
+import batect.dockerclient.native.FreePruneContainersReturn
 import batect.dockerclient.native.FreePullImageReturn
+import batect.dockerclient.native.FreePushImageReturn
+import batect.dockerclient.native.FreeWaitForContainerToBeHealthyReturn
 import batect.dockerclient.native.FreeWaitForContainerToExitReturn
 import batect.dockerclient.native.GetDaemonVersionInformationReturn
 import batect.dockerclient.native.GetImageReturn
 import batect.dockerclient.native.GetNetworkByNameOrIDReturn
 import batect.dockerclient.native.InspectContainerReturn
 import batect.dockerclient.native.InspectExecReturn
+import batect.dockerclient.native.InspectImageReturn
 import batect.dockerclient.native.ListAllVolumesReturn
+import batect.dockerclient.native.ListContainersReturn
+import batect.dockerclient.native.ListImagesReturn
 import batect.dockerclient.native.LoadClientConfigurationFromCLIContextReturn
+import batect.dockerclient.native.LoadImagesReturn
 import batect.dockerclient.native.PingReturn
+import batect.dockerclient.native.PruneContainersReturn
 import batect.dockerclient.native.PullImageReturn
+import batect.dockerclient.native.PushImageReturn
+import batect.dockerclient.native.WaitForContainerToBeHealthyReturn
 import batect.dockerclient.native.WaitForContainerToExitReturn
 import kotlinx.cinterop.CPointer
 import kotlinx.cinterop.StableRef
@@ -83,6 +103,16 @@ internal inline fun <R> CPointer<WaitForContainerToExitReturn>.use(user: (CPoint
 internal inline fun <R> CPointer<InspectContainerReturn>.use(user: (CPointer<InspectContainerReturn>) -> R): R = use(::FreeInspectContainerReturn, user)
 internal inline fun <R> CPointer<CreateExecReturn>.use(user: (CPointer<CreateExecReturn>) -> R): R = use(::FreeCreateExecReturn, user)
 internal inline fun <R> CPointer<InspectExecReturn>.use(user: (CPointer<InspectExecReturn>) -> R): R = use(::FreeInspectExecReturn, user)
+internal inline fun <R> CPointer<ListContainersReturn>.use(user: (CPointer<ListContainersReturn>) -> R): R = use(::FreeListContainersReturn, user)
+internal inline fun <R> CPointer<WaitForContainerToBeHealthyReturn>.use(user: (CPointer<WaitForContainerToBeHealthyReturn>) -> R): R = use(::FreeWaitForContainerToBeHealthyReturn, user)
+internal inline fun <R> CPointer<CommitContainerReturn>.use(user: (CPointer<CommitContainerReturn>) -> R): R = use(::FreeCommitContainerReturn, user)
+internal inline fun <R> CPointer<ContainerChangesReturn>.use(user: (CPointer<ContainerChangesReturn>) -> R): R = use(::FreeContainerChangesReturn, user)
+internal inline fun <R> CPointer<ContainerTopReturn>.use(user: (CPointer<ContainerTopReturn>) -> R): R = use(::FreeContainerTopReturn, user)
+internal inline fun <R> CPointer<PruneContainersReturn>.use(user: (CPointer<PruneContainersReturn>) -> R): R = use(::FreePruneContainersReturn, user)
+internal inline fun <R> CPointer<InspectImageReturn>.use(user: (CPointer<InspectImageReturn>) -> R): R = use(::FreeInspectImageReturn, user)
+internal inline fun <R> CPointer<ListImagesReturn>.use(user: (CPointer<ListImagesReturn>) -> R): R = use(::FreeListImagesReturn, user)
+internal inline fun <R> CPointer<PushImageReturn>.use(user: (CPointer<PushImageReturn>) -> R): R = use(::FreePushImageReturn, user)
+internal inline fun <R> CPointer<LoadImagesReturn>.use(user: (CPointer<LoadImagesReturn>) -> R): R = use(::FreeLoadImagesReturn, user)
 internal inline fun <R> CPointer<Error>?.use(user: (CPointer<Error>?) -> R): R = use(::FreeError, user)
 
 internal inline fun CPointer<Error>?.ifFailed(onError: (CPointer<Error>) -> Unit) = use { error ->
@@ -22,6 +22,10 @@ import batect.dockerclient.io.TextOutput
 import batect.dockerclient.native.AttachToContainerOutput
 import batect.dockerclient.native.BuildImage
 import batect.dockerclient.native.BuildImageProgressUpdate
+import batect.dockerclient.native.CommitContainer
+import batect.dockerclient.native.ConnectContainerToNetwork
+import batect.dockerclient.native.ContainerChanges
+import batect.dockerclient.native.ContainerTop
 import batect.dockerclient.native.CreateClient
 import batect.dockerclient.native.CreateContainer
 import batect.dockerclient.native.CreateExec
@@ -30,25 +34,52 @@ import batect.dockerclient.native.CreateVolume
 import batect.dockerclient.native.DeleteImage
 import batect.dockerclient.native.DeleteNetwork
 import batect.dockerclient.native.DeleteVolume
+import batect.dockerclient.native.DisconnectContainerFromNetwork
 import batect.dockerclient.native.DisposeClient
 import batect.dockerclient.native.DockerClientHandle
+import batect.dockerclient.native.DownloadArchiveFromContainer
+import batect.dockerclient.native.DownloadFromContainer
+import batect.dockerclient.native.ExportContainer
+import batect.dockerclient.native.ExportContainerToFile
 import batect.dockerclient.native.GetDaemonVersionInformation
 import batect.dockerclient.native.GetImage
 import batect.dockerclient.native.GetNetworkByNameOrID
 import batect.dockerclient.native.InspectContainer
 import batect.dockerclient.native.InspectExec
+import batect.dockerclient.native.InspectImage
+import batect.dockerclient.native.KillContainer
 import batect.dockerclient.native.ListAllVolumes
+import batect.dockerclient.native.ListContainers
+import batect.dockerclient.native.ListImages
+import batect.dockerclient.native.LoadImages
+import batect.dockerclient.native.LoadImagesFromFile
+import batect.dockerclient.native.PauseContainer
 import batect.dockerclient.native.Ping
+import batect.dockerclient.native.PruneContainers
 import batect.dockerclient.native.PruneImageBuildCache
 import batect.dockerclient.native.PullImage
 import batect.dockerclient.native.PullImageProgressUpdate
+import batect.dockerclient.native.PullImageReturn
+import batect.dockerclient.native.PushImage
 import batect.dockerclient.native.RemoveContainer
+import batect.dockerclient.native.RenameContainer
+import batect.dockerclient.native.ResizeContainerTTY
+import batect.dockerclient.native.ResizeExecTTY
+import batect.dockerclient.native.RestartContainer
+import batect.dockerclient.native.SaveImages
+import batect.dockerclient.native.SaveImagesToFile
 import batect.dockerclient.native.StartAndAttachToExec
 import batect.dockerclient.native.StartContainer
 import batect.dockerclient.native.StartExecDetached
 import batect.dockerclient.native.StopContainer
+import batect.dockerclient.native.StreamContainerLogs
+import batect.dockerclient.native.StreamContainerStats
 import batect.dockerclient.native.StreamEvents
+import batect.dockerclient.native.UnpauseContainer
+import batect.dockerclient.native.UpdateContainer
+import batect.dockerclient.native.UploadPathsToContainer
 import batect.dockerclient.native.UploadToContainer
+import batect.dockerclient.native.WaitForContainerToBeHealthy
 import batect.dockerclient.native.WaitForContainerToExit
 import kotlinx.cinterop.cstr
 import kotlinx.cinterop.memScoped
@@ -58,6 +89,7 @@ import kotlinx.cinterop.toKString
 import kotlinx.coroutines.coroutineScope
 import kotlinx.coroutines.launch
 import kotlinx.datetime.Instant
+import okio.Path
 import kotlin.time.Duration
 
 @OptIn(kotlinx.cinterop.ExperimentalForeignApi::class)
@@ -187,14 +219,44 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
-    override suspend fun pullImage(name: String, onProgressUpdate: ImagePullProgressReceiver): ImageReference {
+    override suspend fun connectContainerToNetwork(container: ContainerReference, attachment: NetworkAttachment) {
+        launchWithGolangContext { context ->
+            memScoped {
+                ConnectContainerToNetwork(clientHandle, context.handle, container.id.cstr, allocContainerNetworkAttachment(attachment).ptr).ifFailed { error ->
+                    throw ContainerNetworkConnectionFailedException(error.pointed)
+                }
+            }
+        }
+    }
+
+    override suspend fun disconnectContainerFromNetwork(container: ContainerReference, network: NetworkReference, force: Boolean) {
+        launchWithGolangContext { context ->
+            DisconnectContainerFromNetwork(clientHandle, context.handle, container.id.cstr, network.id.cstr, force).ifFailed { error ->
+                throw ContainerNetworkDisconnectionFailedException(error.pointed)
+            }
+        }
+    }
+
+    override suspend fun pullImage(name: String, platform: String?, onProgressUpdate: ImagePullProgressReceiver): ImageReference =
+        pullImage(name, platform, false, onProgressUpdate) { ret -> ImageReference(ret.Response!!.pointed) }
+
+    override suspend fun pullAllImageTags(name: String, platform: String?, onProgressUpdate: ImagePullProgressReceiver): Set<ImageReference> =
+        pullImage(name, platform, true, onProgressUpdate) { ret -> fromArray(ret.AllTagsImages!!, ret.AllTagsImagesCount) { ImageReference(it) }.toSet() }
+
+    private suspend fun <T> pullImage(
+        name: String,
+        platform: String?,
+        allTags: Boolean,
+        onProgressUpdate: ImagePullProgressReceiver,
+        resultFromNative: (PullImageReturn) -> T,
+    ): T {
         return launchWithGolangContext { context ->
             val callbackState = CallbackState<PullImageProgressUpdate> { progress ->
                 onProgressUpdate.invoke(ImagePullProgressUpdate(progress!!.pointed))
             }
 
             callbackState.use { callback, callbackUserData ->
-                PullImage(clientHandle, context.handle, name.cstr, callback, callbackUserData)!!.use { ret ->
+                PullImage(clientHandle, context.handle, name.cstr, (platform ?: "").cstr, allTags, callback, callbackUserData)!!.use { ret ->
                     if (ret.pointed.Error != null) {
                         val errorType = ret.pointed.Error!!.pointed.Type!!.toKString()
 
@@ -205,7 +267,31 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
                         throw ImagePullFailedException(ret.pointed.Error!!.pointed)
                     }
 
-                    ImageReference(ret.pointed.Response!!.pointed)
+                    resultFromNative(ret.pointed)
+                }
+            }
+        }
+    }
+
+    override suspend fun pushImage(name: String, onProgressUpdate: ImagePullProgressReceiver): String {
+        return launchWithGolangContext { context ->
+            val callbackState = CallbackState<PullImageProgressUpdate> { progress ->
+                onProgressUpdate.invoke(ImagePullProgressUpdate(progress!!.pointed))
+            }
+
+            callbackState.use { callback, callbackUserData ->
+                PushImage(clientHandle, context.handle, name.cstr, callback, callbackUserData)!!.use { ret ->
+                    if (ret.pointed.Error != null) {
+                        val errorType = ret.pointed.Error!!.pointed.Type!!.toKString()
+
+                        if (errorType == "main.ProgressCallbackFailedError") {
+                            throw ImagePushFailedException("Image push progress receiver threw an exception: ${callbackState.exceptionThrown}", callbackState.exceptionThrown, errorType)
+                        }
+
+                        throw ImagePushFailedException(ret.pointed.Error!!.pointed)
+                    }
+
+                    ret.pointed.Digest!!.toKString()
                 }
             }
         }
@@ -235,6 +321,88 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun inspectImage(nameOrID: String): ImageInspectionResult {
+        return launchWithGolangContext { context ->
+            InspectImage(clientHandle, context.handle, nameOrID.cstr)!!.use { ret ->
+                if (ret.pointed.Error != null) {
+                    throw ImageInspectionFailedException(ret.pointed.Error!!.pointed)
+                }
+
+                ImageInspectionResult(ret.pointed.Response!!.pointed)
+            }
+        }
+    }
+
+    override suspend fun listImages(all: Boolean, filters: Map<String, Set<String>>): List<ImageSummary> {
+        return launchWithGolangContext { context ->
+            memScoped {
+                ListImages(clientHandle, context.handle, allocListImagesRequest(all, filters).ptr)!!.use { ret ->
+                    if (ret.pointed.Error != null) {
+                        throw ImageListingFailedException(ret.pointed.Error!!.pointed)
+                    }
+
+                    fromArray(ret.pointed.Images!!, ret.pointed.ImagesCount) { ImageSummary(it) }
+                }
+            }
+        }
+    }
+
+    override suspend fun saveImages(names: Set<String>, output: TextOutput) {
+        output.prepareStream().use { stream ->
+            coroutineScope {
+                launch(IODispatcher) { stream.run() }
+
+                launchWithGolangContext { context ->
+                    memScoped {
+                        SaveImages(clientHandle, context.handle, allocSaveImagesRequest(names).ptr, stream.outputStreamHandle).ifFailed { error ->
+                            throw ImageSaveFailedException(error.pointed)
+                        }
+                    }
+                }
+            }
+        }
+    }
+
+    override suspend fun saveImagesToFile(names: Set<String>, destination: Path) {
+        launchWithGolangContext { context ->
+            memScoped {
+                SaveImagesToFile(clientHandle, context.handle, allocSaveImagesRequest(names).ptr, destination.toString().cstr).ifFailed { error ->
+                    throw ImageSaveFailedException(error.pointed)
+                }
+            }
+        }
+    }
+
+    override suspend fun loadImages(input: TextInput): List<String> {
+        return input.prepareStream().use { stream ->
+            coroutineScope {
+                launch(IODispatcher) { stream.run() }
+
+                launchWithGolangContext { context ->
+                    LoadImages(clientHandle, context.handle, stream.inputStreamHandle)!!.use { ret ->
+                        if (ret.pointed.Error != null) {
+                            throw ImageLoadFailedException(ret.pointed.Error!!.pointed)
+                        }
+
+                        fromArray(ret.pointed.References!!, ret.pointed.ReferencesCount) { it.ptr.toKString() }
+                    }
+                }
+            }
+        }
+    }
+
+    override suspend fun loadImagesFromFile(source: Path): List<String> {
+        return launchWithGolangContext { context ->
+            LoadImagesFromFile(clientHandle, context.handle, source.toString().cstr)!!.use { ret ->
+                if (ret.pointed.Error != null) {
+                    throw ImageLoadFailedException(ret.pointed.Error!!.pointed)
+                }
+
+                fromArray(ret.pointed.References!!, ret.pointed.ReferencesCount) { it.ptr.toKString() }
+            }
+        }
+    }
+
     override suspend fun buildImage(spec: ImageBuildSpec, output: TextOutput, onProgressUpdate: ImageBuildProgressReceiver): ImageReference {
         output.prepareStream().use { stream ->
             val callbackState = CallbackState<BuildImageProgressUpdate> { progress ->
@@ -318,12 +486,46 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun restartContainer(container: ContainerReference, timeout: Duration) {
+        launchWithGolangContext { context ->
+            RestartContainer(clientHandle, context.handle, container.id.cstr, timeout.inWholeSeconds).ifFailed { error ->
+                throw ContainerRestartFailedException(error.pointed)
+            }
+        }
+    }
+
+    override suspend fun killContainer(container: ContainerReference, signal: String) {
+        launchWithGolangContext { context ->
+            KillContainer(clientHandle, context.handle, container.id.cstr, signal.cstr).ifFailed { error ->
+                throw ContainerKillFailedException(error.pointed)
+            }
+        }
+    }
+
+    override suspend fun pauseContainer(container: ContainerReference) {
+        launchWithGolangContext { context ->
+            PauseContainer(clientHandle, context.handle, container.id.cstr).ifFailed { error ->
+                throw ContainerPauseFailedException(error.pointed)
+            }
+        }
+    }
+
+    override suspend fun unpauseContainer(container: ContainerReference) {
+        launchWithGolangContext { context ->
+            UnpauseContainer(clientHandle, context.handle, container.id.cstr).ifFailed { error ->
+                throw ContainerUnpauseFailedException(error.pointed)
+            }
+        }
+    }
+
     override suspend fun attachToContainerIO(
         container: ContainerReference,
         stdout: TextOutput?,
         stderr: TextOutput?,
         stdin: TextInput?,
         attachedNotification: ReadyNotification?,
+        includeHistoricalOutput: Boolean,
+        detachKeys: String?,
     ) {
         if (stdout == null && stderr == null && stdin == null) {
             attachedNotification?.markAsReady()
@@ -346,6 +548,8 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
                                     clientHandle,
                                     context.handle,
                                     container.id.cstr,
+                                    includeHistoricalOutput,
+                                    (detachKeys ?: "").cstr,
                                     stdoutStream?.outputStreamHandle ?: 0.toULong(),
                                     stderrStream?.outputStreamHandle ?: 0.toULong(),
                                     stdinStream?.inputStreamHandle ?: 0.toULong(),
@@ -366,6 +570,40 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun streamContainerLogs(
+        container: ContainerReference,
+        stdout: TextOutput?,
+        stderr: TextOutput?,
+        follow: Boolean,
+        showTimestamps: Boolean,
+        tailLines: Long?,
+        since: Instant?,
+        until: Instant?,
+    ) {
+        stdout?.prepareStream().use { stdoutStream ->
+            stderr?.prepareStream().use { stderrStream ->
+                coroutineScope {
+                    launch(IODispatcher) { stdoutStream?.run() }
+                    launch(IODispatcher) { stderrStream?.run() }
+
+                    launchWithGolangContext { context ->
+                        memScoped {
+                            StreamContainerLogs(
+                                clientHandle,
+                                context.handle,
+                                allocStreamContainerLogsRequest(container, follow, showTimestamps, tailLines, since, until).ptr,
+                                stdoutStream?.outputStreamHandle ?: 0.toULong(),
+                                stderrStream?.outputStreamHandle ?: 0.toULong(),
+                            ).ifFailed { error ->
+                                throw StreamingContainerLogsFailedException(error.pointed)
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    }
+
     override suspend fun removeContainer(container: ContainerReference, force: Boolean, removeVolumes: Boolean) {
         launchWithGolangContext { context ->
             RemoveContainer(clientHandle, context.handle, container.id.cstr, force, removeVolumes).ifFailed { error ->
@@ -374,6 +612,38 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun renameContainer(container: ContainerReference, newName: String) {
+        launchWithGolangContext { context ->
+            RenameContainer(clientHandle, context.handle, container.id.cstr, newName.cstr).ifFailed { error ->
+                throw ContainerRenameFailedException(error.pointed)
+            }
+        }
+    }
+
+    override suspend fun updateContainer(container: ContainerReference, update: ContainerUpdateSpec) {
+        launchWithGolangContext { context ->
+            memScoped {
+                UpdateContainer(clientHandle, context.handle, container.id.cstr, allocUpdateContainerRequest(update).ptr).ifFailed { error ->
+                    throw ContainerUpdateFailedException(error.pointed)
+                }
+            }
+        }
+    }
+
+    override suspend fun commitContainer(spec: ContainerCommitSpec): ImageReference {
+        return launchWithGolangContext { context ->
+            memScoped {
+                CommitContainer(clientHandle, context.handle, allocCommitContainerRequest(spec).ptr)!!.use { ret ->
+                    if (ret.pointed.Error != null) {
+                        throw ContainerCommitFailedException(ret.pointed.Error!!.pointed)
+                    }
+
+                    ImageReference(ret.pointed.Response!!.pointed)
+                }
+            }
+        }
+    }
+
     override suspend fun waitForContainerToExit(container: ContainerReference, waitingNotification: ReadyNotification?): Long {
         return launchWithGolangContext { context ->
             val callbackState = ReadyNotificationCallbackState(waitingNotification)
@@ -394,6 +664,18 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun waitForContainerToBeHealthy(container: ContainerReference): ContainerHealthWaitResult {
+        return launchWithGolangContext { context ->
+            WaitForContainerToBeHealthy(clientHandle, context.handle, container.id.cstr)!!.use { ret ->
+                if (ret.pointed.Error != null) {
+                    throw ContainerHealthWaitFailedException(ret.pointed.Error!!.pointed)
+                }
+
+                ContainerHealthWaitResult(ret.pointed.Outcome!!.toKString(), ret.pointed.LastHealthcheckOutput!!.toKString(), ret.pointed.ExitCode)
+            }
+        }
+    }
+
     override suspend fun inspectContainer(idOrName: String): ContainerInspectionResult {
         return launchWithGolangContext { context ->
             InspectContainer(clientHandle, context.handle, idOrName.cstr)!!.use { ret ->
@@ -416,6 +698,118 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun downloadFromContainer(container: ContainerReference, sourcePath: String, destinationDirectory: Path, preserveOwnership: Boolean) {
+        launchWithGolangContext { context ->
+            DownloadFromContainer(clientHandle, context.handle, container.id.cstr, sourcePath.cstr, destinationDirectory.toString().cstr, preserveOwnership).ifFailed { error ->
+                throw ContainerDownloadFailedException(error.pointed)
+            }
+        }
+    }
+
+    override suspend fun downloadArchiveFromContainer(container: ContainerReference, sourcePath: String, output: TextOutput) {
+        output.prepareStream().use { stream ->
+            coroutineScope {
+                launch(IODispatcher) { stream.run() }
+
+                launchWithGolangContext { context ->
+                    DownloadArchiveFromContainer(clientHandle, context.handle, container.id.cstr, sourcePath.cstr, stream.outputStreamHandle).ifFailed { error ->
+                        throw ContainerDownloadFailedException(error.pointed)
+                    }
+                }
+            }
+        }
+    }
+
+    override suspend fun listContainerFilesystemChanges(container: ContainerReference): List<ContainerFilesystemChange> {
+        return launchWithGolangContext { context ->
+            ContainerChanges(clientHandle, context.handle, container.id.cstr)!!.use { ret ->
+                if (ret.pointed.Error != null) {
+                    throw ContainerFilesystemChangesRetrievalFailedException(ret.pointed.Error!!.pointed)
+                }
+
+                fromArray(ret.pointed.Changes!!, ret.pointed.ChangesCount) { ContainerFilesystemChange(it) }
+            }
+        }
+    }
+
+    override suspend fun listContainerProcesses(container: ContainerReference, psArguments: String): ContainerProcessList {
+        return launchWithGolangContext { context ->
+            ContainerTop(clientHandle, context.handle, container.id.cstr, psArguments.cstr)!!.use { ret ->
+                if (ret.pointed.Error != null) {
+                    throw ContainerProcessListingFailedException(ret.pointed.Error!!.pointed)
+                }
+
+                ContainerProcessList(ret.pointed.Response!!.pointed)
+            }
+        }
+    }
+
+    override suspend fun exportContainer(container: ContainerReference, output: TextOutput) {
+        output.prepareStream().use { stream ->
+            coroutineScope {
+                launch(IODispatcher) { stream.run() }
+
+                launchWithGolangContext { context ->
+                    ExportContainer(clientHandle, context.handle, container.id.cstr, stream.outputStreamHandle).ifFailed { error ->
+                        throw ContainerExportFailedException(error.pointed)
+                    }
+                }
+            }
+        }
+    }
+
+    override suspend fun exportContainerToFile(container: ContainerReference, destination: Path) {
+        launchWithGolangContext { context ->
+            ExportContainerToFile(clientHandle, context.handle, container.id.cstr, destination.toString().cstr).ifFailed { error ->
+                throw ContainerExportFailedException(error.pointed)
+            }
+        }
+    }
+
+    override suspend fun listContainers(all: Boolean, filters: Map<String, Set<String>>): List<ContainerSummary> {
+        return launchWithGolangContext { context ->
+            memScoped {
+                ListContainers(clientHandle, context.handle, allocListContainersRequest(all, filters).ptr)!!.use { ret ->
+                    if (ret.pointed.Error != null) {
+                        throw ContainerListingFailedException(ret.pointed.Error!!.pointed)
+                    }
+
+                    fromArray(ret.pointed.Containers!!, ret.pointed.ContainersCount) { ContainerSummary(it) }
+                }
+            }
+        }
+    }
+
+    override suspend fun pruneContainers(filters: Map<String, Set<String>>): ContainerPruneResult {
+        return launchWithGolangContext { context ->
+            memScoped {
+                PruneContainers(clientHandle, context.handle, allocPruneContainersRequest(filters).ptr)!!.use { ret ->
+                    if (ret.pointed.Error != null) {
+                        throw ContainerPruneFailedException(ret.pointed.Error!!.pointed)
+                    }
+
+                    ContainerPruneResult(ret.pointed.Response!!.pointed)
+                }
+            }
+        }
+    }
+
+    override suspend fun uploadPathsToContainer(container: ContainerReference, paths: Set<UploadPath>, destinationPath: String, followSymlinks: Boolean) {
+        return launchWithGolangContext { context ->
+            memScoped {
+                UploadPathsToContainer(
+                    clientHandle,
+                    context.handle,
+                    container.id.cstr,
+                    allocUploadPathsToContainerRequest(paths, followSymlinks).ptr,
+                    destinationPath.cstr,
+                ).ifFailed { error ->
+                    throw ContainerUploadFailedException(error.pointed)
+                }
+            }
+        }
+    }
+
     override suspend fun createExec(spec: ContainerExecSpec): ContainerExecReference {
         return launchWithGolangContext { context ->
             memScoped {
@@ -452,6 +846,22 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun resizeContainerTTY(container: ContainerReference, height: Long, width: Long) {
+        launchWithGolangContext { context ->
+            ResizeContainerTTY(clientHandle, context.handle, container.id.cstr, height, width).ifFailed { error ->
+                throw ContainerTTYResizeFailedException(error.pointed)
+            }
+        }
+    }
+
+    override suspend fun resizeExecTTY(exec: ContainerExecReference, height: Long, width: Long) {
+        launchWithGolangContext { context ->
+            ResizeExecTTY(clientHandle, context.handle, exec.id.cstr, height, width).ifFailed { error ->
+                throw ExecTTYResizeFailedException(error.pointed)
+            }
+        }
+    }
+
     override suspend fun startAndAttachToExec(
         exec: ContainerExecReference,
         attachTTY: Boolean,
@@ -530,6 +940,38 @@ internal actual class RealDockerClient actual constructor(configuration: DockerC
         }
     }
 
+    override suspend fun streamContainerStats(container: ContainerReference, onStatsReceived: ContainerStatsHandler) {
+        launchWithGolangContext { context ->
+            val streamingAbortedException = Exception("Container stats handler aborted streaming.")
+
+            val callbackState = CallbackState<batect.dockerclient.native.ContainerStatsSample> { stats ->
+                if (onStatsReceived.invoke(ContainerStatsSample(stats!!.pointed)) == ContainerStatsHandlerAction.Stop) {
+                    throw streamingAbortedException
+                }
+            }
+
+            callbackState.use { callback, callbackUserData ->
+                StreamContainerStats(clientHandle, context.handle, container.id.cstr, callback, callbackUserData).ifFailed { error ->
+                    val errorType = error.pointed.Type!!.toKString()
+
+                    if (errorType != "main.StatsCallbackFailedError") {
+                        throw StreamingContainerStatsFailedException(error.pointed)
+                    }
+
+                    if (callbackState.exceptionThrown != streamingAbortedException) {
+                        throw StreamingContainerStatsFailedException(
+                            "Container stats receiver threw an exception: ${callbackState.exceptionThrown}",
+                            callbackState.exceptionThrown,
+                            errorType,
+                        )
+                    } else {
+                        // Stats receiver aborted streaming - do not propagate the exception.
+                    }
+                }
+            }
+        }
+    }
+
     override fun close() {
         DisposeClient(clientHandle).ifFailed { error ->
             throw DockerClientException(error.pointed)
@@ -21,8 +21,14 @@ import (
 	"C"
 	"archive/tar"
 	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 	"unsafe"
 
@@ -30,7 +36,9 @@ import (
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/pkg/archive"
 	"github.com/docker/go-connections/nat"
+	"github.com/docker/go-units"
 )
 
 //export CreateContainer
@@ -38,44 +46,123 @@ func CreateContainer(clientHandle DockerClientHandle, contextHandle ContextHandl
 	docker := clientHandle.DockerAPIClient()
 	ctx := contextHandle.Context()
 
-	config := configForContainer(request)
-	hostConfig := hostConfigForContainer(request)
+	exposedPorts, portBindings, err := portMappingsForContainer(request)
+
+	if err != nil {
+		return newCreateContainerReturn(nil, toError(err))
+	}
+
+	config := configForContainer(request, exposedPorts)
+	hostConfig, err := hostConfigForContainer(request, portBindings)
+
+	if err != nil {
+		return newCreateContainerReturn(nil, toError(err))
+	}
+
+	if err := checkMountsSupportedByDaemon(ctx, docker, hostConfig.Mounts); err != nil {
+		return newCreateContainerReturn(nil, toError(err))
+	}
+
 	networkingConfig := network.NetworkingConfig{}
-	networkName := C.GoString(request.NetworkReference)
+	primaryNetwork, additionalNetworks := networksForContainer(request)
 
-	if networkName != "" {
-		hostConfig.NetworkMode = container.NetworkMode(networkName)
+	if primaryNetwork != nil {
+		hostConfig.NetworkMode = container.NetworkMode(primaryNetwork.name)
 
 		networkingConfig.EndpointsConfig = map[string]*network.EndpointSettings{
-			networkName: {
-				Aliases: fromStringArray(request.NetworkAliases, request.NetworkAliasesCount),
-			},
+			primaryNetwork.name: primaryNetwork.settings,
 		}
 	}
 
 	containerName := C.GoString(request.Name)
+	platform, err := parsePlatform(C.GoString(request.Platform))
+
+	if err != nil {
+		return newCreateContainerReturn(nil, toError(err))
+	}
 
-	createdContainer, err := docker.ContainerCreate(ctx, &config, &hostConfig, &networkingConfig, nil, containerName)
+	createdContainer, err := docker.ContainerCreate(ctx, &config, &hostConfig, &networkingConfig, platform, containerName)
 
 	if err != nil {
 		return newCreateContainerReturn(nil, toError(err))
 	}
 
+	// Daemons older than API version 1.44 only accept a single network at creation time, so connect to any others afterwards.
+	for _, n := range additionalNetworks {
+		if err := docker.NetworkConnect(ctx, n.name, createdContainer.ID, n.settings); err != nil {
+			_ = docker.ContainerRemove(ctx, createdContainer.ID, container.RemoveOptions{Force: true})
+
+			return newCreateContainerReturn(nil, toError(err))
+		}
+	}
+
 	return newCreateContainerReturn(newContainerReference(createdContainer.ID), nil)
 }
 
-func configForContainer(request *C.CreateContainerRequest) container.Config {
+type containerNetwork struct {
+	name     string
+	settings *network.EndpointSettings
+}
+
+func networksForContainer(request *C.CreateContainerRequest) (*containerNetwork, []containerNetwork) {
+	count := int(request.NetworksCount)
+	networks := make([]containerNetwork, 0, count+1)
+
+	if networkName := C.GoString(request.NetworkReference); networkName != "" {
+		networks = append(networks, containerNetwork{
+			name:     networkName,
+			settings: &network.EndpointSettings{Aliases: fromStringArray(request.NetworkAliases, request.NetworkAliasesCount)},
+		})
+	}
+
+	for i := 0; i < count; i++ {
+		attachment := C.GetContainerNetworkAttachmentArrayElement(request.Networks, C.uint64_t(i))
+
+		networks = append(networks, containerNetwork{
+			name:     C.GoString(attachment.NetworkReference),
+			settings: endpointSettingsForNetworkAttachment(attachment),
+		})
+	}
+
+	if len(networks) == 0 {
+		return nil, nil
+	}
+
+	return &networks[0], networks[1:]
+}
+
+func endpointSettingsForNetworkAttachment(attachment *C.ContainerNetworkAttachment) *network.EndpointSettings {
+	settings := &network.EndpointSettings{
+		Aliases: fromStringArray(attachment.Aliases, attachment.AliasesCount),
+		Links:   fromStringArray(attachment.Links, attachment.LinksCount),
+	}
+
+	ipv4Address := C.GoString(attachment.IPv4Address)
+	ipv6Address := C.GoString(attachment.IPv6Address)
+
+	if ipv4Address != "" || ipv6Address != "" {
+		settings.IPAMConfig = &network.EndpointIPAMConfig{
+			IPv4Address: ipv4Address,
+			IPv6Address: ipv6Address,
+		}
+	}
+
+	return settings
+}
+
+func configForContainer(request *C.CreateContainerRequest, exposedPorts nat.PortSet) container.Config {
 	config := container.Config{
 		Image:        C.GoString(request.ImageReference),
 		WorkingDir:   C.GoString(request.WorkingDirectory),
 		Hostname:     C.GoString(request.Hostname),
 		Env:          fromStringArray(request.EnvironmentVariables, request.EnvironmentVariablesCount),
-		ExposedPorts: exposedPortsForContainer(request),
+		ExposedPorts: exposedPorts,
 		User:         C.GoString(request.User),
 		Tty:          bool(request.AttachTTY),
 		AttachStdin:  bool(request.AttachStdin),
 		StdinOnce:    bool(request.StdinOnce),
 		OpenStdin:    bool(request.OpenStdin),
+		StopSignal:   C.GoString(request.StopSignal),
 		Labels:       fromStringPairs(request.Labels, request.LabelsCount),
 		Healthcheck: &container.HealthConfig{
 			Interval:    time.Duration(int64(request.HealthcheckInterval)) * time.Nanosecond,
@@ -97,39 +184,146 @@ func configForContainer(request *C.CreateContainerRequest) container.Config {
 		config.Healthcheck.Test = append([]string{"CMD-SHELL"}, fromStringArray(request.HealthcheckCommand, request.HealthcheckCommandCount)...)
 	}
 
+	if request.HaveStopTimeout {
+		stopTimeout := int(request.StopTimeoutSeconds)
+		config.StopTimeout = &stopTimeout
+	}
+
 	return config
 }
 
-func hostConfigForContainer(request *C.CreateContainerRequest) container.HostConfig {
+func hostConfigForContainer(request *C.CreateContainerRequest, portBindings nat.PortMap) (container.HostConfig, error) {
 	useInitProcess := bool(request.UseInitProcess)
+	resources, err := resourcesForContainer(request)
+
+	if err != nil {
+		return container.HostConfig{}, err
+	}
+
+	mounts, err := mountsForContainer(request)
+
+	if err != nil {
+		return container.HostConfig{}, err
+	}
+
+	securityOptions, err := securityOptionsForContainer(request)
+
+	if err != nil {
+		return container.HostConfig{}, err
+	}
+
+	consoleSize, err := toConsoleSize(int64(request.InitialConsoleHeight), int64(request.InitialConsoleWidth))
+
+	if err != nil {
+		return container.HostConfig{}, err
+	}
 
 	hostConfig := container.HostConfig{
-		ExtraHosts:   fromStringArray(request.ExtraHosts, request.ExtraHostsCount),
-		Binds:        fromStringArray(request.BindMounts, request.BindMountsCount),
-		Tmpfs:        fromStringPairs(request.TmpfsMounts, request.TmpfsMountsCount),
-		PortBindings: portBindingsForContainer(request),
-		Init:         &useInitProcess,
-		ShmSize:      int64(request.ShmSizeInBytes),
-		Privileged:   bool(request.Privileged),
-		CapAdd:       fromStringArray(request.CapabilitiesToAdd, request.CapabilitiesToAddCount),
-		CapDrop:      fromStringArray(request.CapabilitiesToDrop, request.CapabilitiesToDropCount),
-		Resources: container.Resources{
-			Devices: devicesForContainer(request),
-		},
+		RestartPolicy:  restartPolicyForContainer(request),
+		AutoRemove:     bool(request.AutoRemove),
+		IpcMode:        container.IpcMode(C.GoString(request.IPCMode)),
+		PidMode:        container.PidMode(C.GoString(request.PIDMode)),
+		UTSMode:        container.UTSMode(C.GoString(request.UTSMode)),
+		ExtraHosts:     fromStringArray(request.ExtraHosts, request.ExtraHostsCount),
+		Binds:          fromStringArray(request.BindMounts, request.BindMountsCount),
+		Tmpfs:          fromStringPairs(request.TmpfsMounts, request.TmpfsMountsCount),
+		Mounts:         mounts,
+		PortBindings:   portBindings,
+		Init:           &useInitProcess,
+		ShmSize:        int64(request.ShmSizeInBytes),
+		Privileged:     bool(request.Privileged),
+		CapAdd:         fromStringArray(request.CapabilitiesToAdd, request.CapabilitiesToAddCount),
+		CapDrop:        fromStringArray(request.CapabilitiesToDrop, request.CapabilitiesToDropCount),
+		SecurityOpt:    securityOptions,
+		ReadonlyRootfs: bool(request.ReadOnlyRootFilesystem),
+		Sysctls:        fromStringPairs(request.Sysctls, request.SysctlsCount),
+		UsernsMode:     container.UsernsMode(C.GoString(request.UsernsMode)),
+		GroupAdd:       fromStringArray(request.GroupAdd, request.GroupAddCount),
+		OomScoreAdj:    int(request.OOMScoreAdjustment),
+		ConsoleSize:    consoleSize,
+		Resources:      resources,
 		LogConfig: container.LogConfig{
 			Type:   C.GoString(request.LogDriver),
 			Config: fromStringPairs(request.LoggingOptions, request.LoggingOptionsCount),
 		},
 	}
 
-	return hostConfig
+	return hostConfig, nil
+}
+
+func restartPolicyForContainer(request *C.CreateContainerRequest) container.RestartPolicy {
+	if request.RestartPolicy == nil {
+		return container.RestartPolicy{}
+	}
+
+	return container.RestartPolicy{
+		Name:              container.RestartPolicyMode(C.GoString(request.RestartPolicy.Name)),
+		MaximumRetryCount: int(request.RestartPolicy.MaximumRetryCount),
+	}
+}
+
+func securityOptionsForContainer(request *C.CreateContainerRequest) ([]string, error) {
+	var opts []string
+
+	if request.NoNewPrivileges {
+		opts = append(opts, "no-new-privileges")
+	}
+
+	// The daemon expects the profile itself rather than a path to it, just like the CLI sends after reading the profile file.
+	if seccompProfile := C.GoString(request.SeccompProfile); seccompProfile != "" {
+		if seccompProfile != "unconfined" && !json.Valid([]byte(seccompProfile)) {
+			return nil, InvalidContainerConfigurationError{Reason: "seccomp profile must be 'unconfined' or a JSON profile"}
+		}
+
+		opts = append(opts, "seccomp="+seccompProfile)
+	}
+
+	if appArmorProfile := C.GoString(request.AppArmorProfile); appArmorProfile != "" {
+		opts = append(opts, "apparmor="+appArmorProfile)
+	}
+
+	for _, label := range fromStringArray(request.SELinuxLabels, request.SELinuxLabelsCount) {
+		opts = append(opts, "label="+label)
+	}
+
+	return opts, nil
+}
+
+func resourcesForContainer(request *C.CreateContainerRequest) (container.Resources, error) {
+	blockIOWeight, err := toBlockIOWeight(int64(request.BlockIOWeight))
+
+	if err != nil {
+		return container.Resources{}, err
+	}
+
+	resources := container.Resources{
+		Devices:           devicesForContainer(request),
+		Memory:            int64(request.MemoryLimitInBytes),
+		MemoryReservation: int64(request.MemoryReservationInBytes),
+		MemorySwap:        int64(request.MemorySwapLimitInBytes),
+		NanoCPUs:          int64(float64(request.CPUCount) * 1e9),
+		CPUQuota:          int64(request.CPUQuota),
+		CPUPeriod:         int64(request.CPUPeriod),
+		CPUShares:         int64(request.CPUShares),
+		CpusetCpus:        C.GoString(request.CPUSetCPUs),
+		CpusetMems:        C.GoString(request.CPUSetMemoryNodes),
+		Ulimits:           ulimitsForContainer(request),
+		BlkioWeight:       blockIOWeight,
+	}
+
+	if request.PidsLimit != 0 {
+		pidsLimit := int64(request.PidsLimit)
+		resources.PidsLimit = &pidsLimit
+	}
+
+	return resources, nil
 }
 
 //export StartContainer
 func StartContainer(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char) Error {
 	docker := clientHandle.DockerAPIClient()
 	ctx := contextHandle.Context()
-	opts := types.ContainerStartOptions{}
+	opts := container.StartOptions{}
 
 	if err := docker.ContainerStart(ctx, C.GoString(id), opts); err != nil {
 		return toError(err)
@@ -154,12 +348,168 @@ func StopContainer(clientHandle DockerClientHandle, contextHandle ContextHandle,
 	return nil
 }
 
+//export RestartContainer
+func RestartContainer(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char, timeoutSeconds C.int64_t) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+	timeout := int(timeoutSeconds)
+	opts := container.StopOptions{
+		Timeout: &timeout,
+	}
+
+	if err := docker.ContainerRestart(ctx, C.GoString(id), opts); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
+
+//export KillContainer
+func KillContainer(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char, signal *C.char) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	if err := docker.ContainerKill(ctx, C.GoString(id), C.GoString(signal)); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
+
+//export PauseContainer
+func PauseContainer(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	if err := docker.ContainerPause(ctx, C.GoString(id)); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
+
+//export UnpauseContainer
+func UnpauseContainer(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	if err := docker.ContainerUnpause(ctx, C.GoString(id)); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
+
+//export ResizeContainerTTY
+func ResizeContainerTTY(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char, height C.int64_t, width C.int64_t) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	opts, err := toResizeOptions(height, width)
+
+	if err != nil {
+		return toError(err)
+	}
+
+	if err := docker.ContainerResize(ctx, C.GoString(id), opts); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
+
+//export RenameContainer
+func RenameContainer(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char, newName *C.char) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	if err := docker.ContainerRename(ctx, C.GoString(id), C.GoString(newName)); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
+
+//export UpdateContainer
+func UpdateContainer(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char, request *C.UpdateContainerRequest) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	blockIOWeight, err := toBlockIOWeight(int64(request.BlockIOWeight))
+
+	if err != nil {
+		return toError(err)
+	}
+
+	// Zero values are ignored by the daemon, so any settings not given in the request are left unchanged.
+	config := container.UpdateConfig{
+		Resources: container.Resources{
+			Memory:            int64(request.MemoryLimitInBytes),
+			MemoryReservation: int64(request.MemoryReservationInBytes),
+			MemorySwap:        int64(request.MemorySwapLimitInBytes),
+			NanoCPUs:          int64(float64(request.CPUCount) * 1e9),
+			CPUQuota:          int64(request.CPUQuota),
+			CPUPeriod:         int64(request.CPUPeriod),
+			CPUShares:         int64(request.CPUShares),
+			CpusetCpus:        C.GoString(request.CPUSetCPUs),
+			CpusetMems:        C.GoString(request.CPUSetMemoryNodes),
+			BlkioWeight:       blockIOWeight,
+		},
+	}
+
+	if request.PidsLimit != 0 {
+		pidsLimit := int64(request.PidsLimit)
+		config.PidsLimit = &pidsLimit
+	}
+
+	if request.RestartPolicy != nil {
+		config.RestartPolicy = container.RestartPolicy{
+			Name:              container.RestartPolicyMode(C.GoString(request.RestartPolicy.Name)),
+			MaximumRetryCount: int(request.RestartPolicy.MaximumRetryCount),
+		}
+	}
+
+	if _, err := docker.ContainerUpdate(ctx, C.GoString(id), config); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
+
+//export CommitContainer
+func CommitContainer(clientHandle DockerClientHandle, contextHandle ContextHandle, request *C.CommitContainerRequest) CommitContainerReturn {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	opts := container.CommitOptions{
+		Reference: C.GoString(request.Reference),
+		Comment:   C.GoString(request.Message),
+		Author:    C.GoString(request.Author),
+		Changes:   fromStringArray(request.Changes, request.ChangesCount),
+		Pause:     bool(request.Pause),
+	}
+
+	if request.LabelsCount > 0 {
+		opts.Config = &container.Config{
+			Labels: fromStringPairs(request.Labels, request.LabelsCount),
+		}
+	}
+
+	resp, err := docker.ContainerCommit(ctx, C.GoString(request.ContainerID), opts)
+
+	if err != nil {
+		return newCommitContainerReturn(nil, toError(err))
+	}
+
+	return newCommitContainerReturn(newImageReference(resp.ID), nil)
+}
+
 //export RemoveContainer
 func RemoveContainer(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char, force C.bool, removeVolumes C.bool) Error {
 	docker := clientHandle.DockerAPIClient()
 	ctx := contextHandle.Context()
 
-	opts := types.ContainerRemoveOptions{
+	opts := container.RemoveOptions{
 		Force:         bool(force),
 		RemoveVolumes: bool(removeVolumes),
 	}
@@ -177,6 +527,8 @@ func AttachToContainerOutput(
 	clientHandle DockerClientHandle,
 	contextHandle ContextHandle,
 	id *C.char,
+	includeHistoricalOutput C.bool,
+	detachKeys *C.char,
 	stdoutStreamHandle OutputStreamHandle,
 	stderrStreamHandle OutputStreamHandle,
 	stdinStreamHandle InputStreamHandle,
@@ -197,13 +549,22 @@ func AttachToContainerOutput(
 		return toError(err)
 	}
 
-	opts := types.ContainerAttachOptions{
-		Logs:   true,
-		Stream: true,
+	keys := C.GoString(detachKeys)
+
+	if _, err := replacements.DetachKeysToBytes(keys); err != nil {
+		return toError(err)
+	}
+
+	opts := container.AttachOptions{
+		Logs:       bool(includeHistoricalOutput),
+		Stream:     true,
+		DetachKeys: keys,
 	}
 
 	streamer := replacements.HijackedIOStreamer{
-		Tty: config.Config.Tty,
+		Tty:                config.Config.Tty,
+		DetachOnEscapeKeys: true,
+		DetachKeys:         keys,
 	}
 
 	if stdoutStreamHandle != 0 {
@@ -239,7 +600,8 @@ func AttachToContainerOutput(
 		replacements.StartMonitoringTTYSizeForContainer(ctx, docker, stdoutStreamHandle.OutputStream(), containerID)
 	}
 
-	if err := streamer.Stream(ctx); err != nil {
+	// Detaching leaves the container running, so it's not an error from the caller's point of view.
+	if err := streamer.Stream(ctx); err != nil && !errors.Is(err, replacements.ErrDetached) {
 		return toError(err)
 	}
 
@@ -288,35 +650,192 @@ func InspectContainer(clientHandle DockerClientHandle, contextHandle ContextHand
 	}
 
 	logConfig := newContainerLogConfig(resp.HostConfig.LogConfig.Type, toStringPairs(resp.HostConfig.LogConfig.Config))
-	hostConfig := newContainerHostConfig(logConfig)
+	restartPolicy := newRestartPolicy(string(resp.HostConfig.RestartPolicy.Name), int64(resp.HostConfig.RestartPolicy.MaximumRetryCount))
+	hostConfig := newContainerHostConfig(logConfig, restartPolicy)
+
+	result := newContainerInspectionResult(
+		resp.ID,
+		resp.Name,
+		hostConfig,
+		toContainerState(resp.State),
+		toContainerConfig(resp.Config),
+		toContainerNetworkSettings(resp.NetworkSettings),
+		parseTimestamp(resp.Created),
+		resp.Image,
+		int64(resp.RestartCount),
+		toContainerMountPoints(resp.Mounts),
+	)
 
+	return newInspectContainerReturn(result, nil)
+}
+
+func toContainerState(s *types.ContainerState) ContainerState {
 	var health ContainerHealthState
 
-	if resp.State.Health == nil {
+	if s.Health == nil {
 		health = newContainerHealthState("", []ContainerHealthLogEntry{})
 	} else {
-		health = newContainerHealthState(resp.State.Health.Status, toContainerHealthLogEntries(resp.State.Health.Log))
+		health = newContainerHealthState(s.Health.Status, toContainerHealthLogEntries(s.Health.Log))
 	}
 
-	state := newContainerState(health)
+	return newContainerState(
+		health,
+		s.Status,
+		s.Running,
+		s.Paused,
+		s.Restarting,
+		s.OOMKilled,
+		s.Dead,
+		int64(s.Pid),
+		int64(s.ExitCode),
+		s.Error,
+		parseTimestamp(s.StartedAt),
+		parseTimestamp(s.FinishedAt),
+	)
+}
+
+func toContainerConfig(c *container.Config) ContainerConfig {
+	var stopTimeout int64
+
+	if c.StopTimeout != nil {
+		stopTimeout = int64(*c.StopTimeout)
+	}
+
+	return newContainerConfig(
+		toStringPairs(c.Labels),
+		toContainerHealthcheckConfig(c.Healthcheck),
+		c.StopSignal,
+		c.StopTimeout != nil,
+		stopTimeout,
+		c.Image,
+		c.Env,
+		c.Cmd,
+		c.Entrypoint,
+		c.WorkingDir,
+		c.User,
+	)
+}
+
+func toContainerHealthcheckConfig(healthcheck *container.HealthConfig) ContainerHealthcheckConfig {
+	if healthcheck == nil {
+		return nil
+	}
+
+	return newContainerHealthcheckConfig(
+		healthcheck.Test,
+		healthcheck.Interval.Nanoseconds(),
+		healthcheck.Timeout.Nanoseconds(),
+		healthcheck.StartPeriod.Nanoseconds(),
+		int64(healthcheck.Retries),
+	)
+}
+
+func toContainerNetworkSettings(settings *types.NetworkSettings) ContainerNetworkSettings {
+	if settings == nil {
+		return newContainerNetworkSettings(nil, nil)
+	}
 
-	var healthcheckConfig ContainerHealthcheckConfig
+	names := make([]string, 0, len(settings.Networks))
 
-	if resp.Config.Healthcheck != nil {
-		healthcheckConfig = newContainerHealthcheckConfig(
-			resp.Config.Healthcheck.Test,
-			resp.Config.Healthcheck.Interval.Nanoseconds(),
-			resp.Config.Healthcheck.Timeout.Nanoseconds(),
-			resp.Config.Healthcheck.StartPeriod.Nanoseconds(),
-			int64(resp.Config.Healthcheck.Retries),
+	for name := range settings.Networks {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	networks := make([]ContainerNetworkEndpoint, 0, len(names))
+
+	for _, name := range names {
+		endpoint := settings.Networks[name]
+
+		networks = append(networks, newContainerNetworkEndpoint(
+			name,
+			endpoint.NetworkID,
+			endpoint.EndpointID,
+			endpoint.Aliases,
+			endpoint.IPAddress,
+			int64(endpoint.IPPrefixLen),
+			endpoint.Gateway,
+			endpoint.GlobalIPv6Address,
+			int64(endpoint.GlobalIPv6PrefixLen),
+			endpoint.IPv6Gateway,
+			endpoint.MacAddress,
+		))
+	}
+
+	return newContainerNetworkSettings(fromPortMap(settings.Ports), networks)
+}
+
+func toContainerMountPoints(mounts []types.MountPoint) []ContainerMountPoint {
+	l := make([]ContainerMountPoint, 0, len(mounts))
+
+	for _, m := range mounts {
+		mountPoint := newContainerMountPoint(
+			string(m.Type),
+			m.Name,
+			m.Source,
+			m.Destination,
+			m.Driver,
+			m.Mode,
+			m.RW,
+			string(m.Propagation),
 		)
+
+		l = append(l, mountPoint)
 	}
 
-	labels := toStringPairs(resp.Config.Labels)
-	config := newContainerConfig(labels, healthcheckConfig)
-	result := newContainerInspectionResult(resp.ID, resp.Name, hostConfig, state, config)
+	return l
+}
 
-	return newInspectContainerReturn(result, nil)
+//export ListContainers
+func ListContainers(clientHandle DockerClientHandle, contextHandle ContextHandle, request *C.ListContainersRequest) ListContainersReturn {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	opts := container.ListOptions{
+		All:     bool(request.All),
+		Filters: toFilterArgs(request.Filters, request.FiltersCount),
+	}
+
+	dockerResponse, err := docker.ContainerList(ctx, opts)
+
+	if err != nil {
+		return newListContainersReturn(nil, toError(err))
+	}
+
+	containers := make([]ContainerSummary, 0, len(dockerResponse))
+
+	for _, c := range dockerResponse {
+		summary := newContainerSummary(
+			c.ID,
+			c.Names,
+			c.Image,
+			toStringPairs(c.Labels),
+			c.State,
+			c.Status,
+			toPortBindings(c.Ports),
+		)
+
+		containers = append(containers, summary)
+	}
+
+	return newListContainersReturn(containers, nil)
+}
+
+//export PruneContainers
+func PruneContainers(clientHandle DockerClientHandle, contextHandle ContextHandle, request *C.PruneContainersRequest) PruneContainersReturn {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	dockerResponse, err := docker.ContainersPrune(ctx, toFilterArgs(request.Filters, request.FiltersCount))
+
+	if err != nil {
+		return newPruneContainersReturn(nil, toError(err))
+	}
+
+	result := newPruneContainersResult(dockerResponse.ContainersDeleted, int64(dockerResponse.SpaceReclaimed))
+
+	return newPruneContainersReturn(result, nil)
 }
 
 //export UploadToContainer
@@ -380,6 +899,68 @@ func UploadToContainer(clientHandle DockerClientHandle, contextHandle ContextHan
 	return nil
 }
 
+//export DownloadFromContainer
+func DownloadFromContainer(
+	clientHandle DockerClientHandle,
+	contextHandle ContextHandle,
+	containerID *C.char,
+	sourcePath *C.char,
+	destinationDirectory *C.char,
+	preserveOwnership C.bool,
+) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	content, _, err := docker.CopyFromContainer(ctx, C.GoString(containerID), C.GoString(sourcePath))
+
+	if err != nil {
+		return toError(err)
+	}
+
+	defer content.Close()
+
+	// Untar refuses to extract any entry that would end up outside the destination directory.
+	// Like docker cp, extracted files are owned by the current user unless the caller asks to keep the ownership from the container.
+	opts := &archive.TarOptions{
+		NoLchown:             !bool(preserveOwnership),
+		NoOverwriteDirNonDir: true,
+	}
+
+	if err := archive.Untar(content, C.GoString(destinationDirectory), opts); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
+
+//export DownloadArchiveFromContainer
+func DownloadArchiveFromContainer(
+	clientHandle DockerClientHandle,
+	contextHandle ContextHandle,
+	containerID *C.char,
+	sourcePath *C.char,
+	outputStreamHandle OutputStreamHandle,
+) Error {
+	defer outputStreamHandle.Close()
+
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	content, _, err := docker.CopyFromContainer(ctx, C.GoString(containerID), C.GoString(sourcePath))
+
+	if err != nil {
+		return toError(err)
+	}
+
+	defer content.Close()
+
+	if _, err := io.Copy(outputStreamHandle.OutputStream(), content); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
+
 func fromStringPairs(pairs **C.StringPair, count C.uint64_t) map[string]string {
 	m := make(map[string]string, count)
 
@@ -424,39 +1005,138 @@ func devicesForContainer(request *C.CreateContainerRequest) []container.DeviceMa
 	return devices
 }
 
-func portBindingsForContainer(request *C.CreateContainerRequest) nat.PortMap {
-	portMap := nat.PortMap{}
-	count := request.ExposedPortsCount
+// toBlockIOWeight checks the weight fits in a uint16. The daemon is responsible for checking it's within the range it supports.
+func toBlockIOWeight(weight int64) (uint16, error) {
+	if weight < 0 || weight > math.MaxUint16 {
+		return 0, InvalidContainerConfigurationError{Reason: fmt.Sprintf("block IO weight %d is out of range", weight)}
+	}
+
+	return uint16(weight), nil
+}
+
+func ulimitsForContainer(request *C.CreateContainerRequest) []*units.Ulimit {
+	count := request.UlimitsCount
+	ulimits := make([]*units.Ulimit, 0, count)
 
 	for i := 0; i < int(count); i++ {
-		requested := C.GetExposedPortArrayElement(request.ExposedPorts, C.uint64_t(i))
-		port := nat.Port(fmt.Sprintf("%v/%v", requested.ContainerPort, C.GoString(requested.Protocol)))
+		requested := C.GetUlimitArrayElement(request.Ulimits, C.uint64_t(i))
 
-		if _, ok := portMap[port]; !ok {
-			portMap[port] = []nat.PortBinding{}
+		ulimit := &units.Ulimit{
+			Name: C.GoString(requested.Name),
+			Soft: int64(requested.Soft),
+			Hard: int64(requested.Hard),
 		}
 
-		portMap[port] = append(portMap[port], nat.PortBinding{
-			HostIP:   "",
-			HostPort: strconv.FormatInt(int64(requested.LocalPort), 10),
-		})
+		ulimits = append(ulimits, ulimit)
 	}
 
-	return portMap
+	return ulimits
 }
 
-func exposedPortsForContainer(request *C.CreateContainerRequest) nat.PortSet {
+func portMappingsForContainer(request *C.CreateContainerRequest) (nat.PortSet, nat.PortMap, error) {
 	portSet := nat.PortSet{}
+	portMap := nat.PortMap{}
 	count := request.ExposedPortsCount
 
 	for i := 0; i < int(count); i++ {
 		requested := C.GetExposedPortArrayElement(request.ExposedPorts, C.uint64_t(i))
-		port := fmt.Sprintf("%v/%v", requested.ContainerPort, C.GoString(requested.Protocol))
+		mappings, err := nat.ParsePortSpec(portSpecForExposedPort(requested))
+
+		if err != nil {
+			return nil, nil, InvalidContainerConfigurationError{Reason: err.Error()}
+		}
 
-		portSet[nat.Port(port)] = struct{}{}
+		for _, mapping := range mappings {
+			portSet[mapping.Port] = struct{}{}
+			portMap[mapping.Port] = append(portMap[mapping.Port], mapping.Binding)
+		}
+	}
+
+	return portSet, portMap, nil
+}
+
+// portSpecForExposedPort formats the port in the same way as the CLI's --publish flag, for example "127.0.0.1:8000-8010:80-90/tcp".
+func portSpecForExposedPort(port *C.ExposedPort) string {
+	hostPort := ""
+
+	// An empty host port means the daemon will choose a free port.
+	if port.LocalPort != 0 {
+		hostPort = formatPortRange(int64(port.LocalPort), int64(port.LocalPortRangeEnd))
+	}
+
+	spec := hostPort + ":" + formatPortRange(int64(port.ContainerPort), int64(port.ContainerPortRangeEnd))
+
+	if hostIP := C.GoString(port.LocalIP); hostIP != "" {
+		if strings.Contains(hostIP, ":") {
+			hostIP = "[" + hostIP + "]"
+		}
+
+		spec = hostIP + ":" + spec
 	}
 
-	return portSet
+	if protocol := C.GoString(port.Protocol); protocol != "" {
+		spec += "/" + protocol
+	}
+
+	return spec
+}
+
+func formatPortRange(start int64, end int64) string {
+	if end == 0 || end == start {
+		return strconv.FormatInt(start, 10)
+	}
+
+	return fmt.Sprintf("%v-%v", start, end)
+}
+
+func fromPortMap(portMap nat.PortMap) []PortBinding {
+	ports := make([]nat.Port, 0, len(portMap))
+
+	for port := range portMap {
+		ports = append(ports, port)
+	}
+
+	nat.Sort(ports, func(i, j nat.Port) bool {
+		return i.Int() < j.Int() || (i.Int() == j.Int() && i.Proto() < j.Proto())
+	})
+
+	l := make([]PortBinding, 0, len(portMap))
+
+	for _, port := range ports {
+		bindings := portMap[port]
+
+		// Ports that are exposed but not published have no bindings.
+		if len(bindings) == 0 {
+			l = append(l, newPortBinding(int64(port.Int()), port.Proto(), "", 0))
+
+			continue
+		}
+
+		for _, binding := range bindings {
+			hostPort, _ := strconv.ParseInt(binding.HostPort, 10, 64)
+
+			l = append(l, newPortBinding(int64(port.Int()), port.Proto(), binding.HostIP, hostPort))
+		}
+	}
+
+	return l
+}
+
+func toPortBindings(ports []types.Port) []PortBinding {
+	l := make([]PortBinding, 0, len(ports))
+
+	for _, port := range ports {
+		binding := newPortBinding(
+			int64(port.PrivatePort),
+			port.Type,
+			port.IP,
+			int64(port.PublicPort),
+		)
+
+		l = append(l, binding)
+	}
+
+	return l
 }
 
 func toContainerHealthLogEntries(results []*types.HealthcheckResult) []ContainerHealthLogEntry {
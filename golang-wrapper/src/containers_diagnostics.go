@@ -0,0 +1,129 @@
+// Copyright 2017-2022 Charles Korn.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	/*
+		#include "types.h"
+	*/
+	"C"
+	"io"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+//export ContainerChanges
+func ContainerChanges(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char) ContainerChangesReturn {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	dockerResponse, err := docker.ContainerDiff(ctx, C.GoString(id))
+
+	if err != nil {
+		return newContainerChangesReturn(nil, toError(err))
+	}
+
+	changes := make([]ContainerFilesystemChange, 0, len(dockerResponse))
+
+	for _, change := range dockerResponse {
+		changes = append(changes, newContainerFilesystemChange(change.Path, changeKindName(change.Kind)))
+	}
+
+	return newContainerChangesReturn(changes, nil)
+}
+
+func changeKindName(kind container.ChangeType) string {
+	switch kind {
+	case container.ChangeModify:
+		return "modified"
+	case container.ChangeAdd:
+		return "added"
+	case container.ChangeDelete:
+		return "deleted"
+	default:
+		return kind.String()
+	}
+}
+
+//export ContainerTop
+func ContainerTop(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char, psArguments *C.char) ContainerTopReturn {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	// Arguments are passed to the daemon in the same form as the CLI's "docker top" command, eg. "-eo pid,comm".
+	var args []string
+
+	if psArgs := strings.TrimSpace(C.GoString(psArguments)); psArgs != "" {
+		args = []string{psArgs}
+	}
+
+	dockerResponse, err := docker.ContainerTop(ctx, C.GoString(id), args)
+
+	if err != nil {
+		return newContainerTopReturn(nil, toError(err))
+	}
+
+	processes := make([]ContainerProcess, 0, len(dockerResponse.Processes))
+
+	for _, values := range dockerResponse.Processes {
+		processes = append(processes, newContainerProcess(values))
+	}
+
+	return newContainerTopReturn(newContainerTopResult(dockerResponse.Titles, processes), nil)
+}
+
+//export ExportContainer
+func ExportContainer(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char, outputStreamHandle OutputStreamHandle) Error {
+	defer outputStreamHandle.Close()
+
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	content, err := docker.ContainerExport(ctx, C.GoString(id))
+
+	if err != nil {
+		return toError(err)
+	}
+
+	defer content.Close()
+
+	if _, err := io.Copy(outputStreamHandle.OutputStream(), content); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
+
+//export ExportContainerToFile
+func ExportContainerToFile(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char, destinationPath *C.char) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+	path := C.GoString(destinationPath)
+
+	content, err := docker.ContainerExport(ctx, C.GoString(id))
+
+	if err != nil {
+		return toError(err)
+	}
+
+	defer content.Close()
+
+	if err := writeToFile(path, content); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
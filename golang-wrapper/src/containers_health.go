@@ -0,0 +1,137 @@
+// Copyright 2017-2022 Charles Korn.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	/*
+		#include "types.h"
+	*/
+	"C"
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	filtertypes "github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+)
+
+const (
+	healthWaitOutcomeHealthy   = "healthy"
+	healthWaitOutcomeUnhealthy = "unhealthy"
+	healthWaitOutcomeExited    = "exited"
+)
+
+//export WaitForContainerToBeHealthy
+func WaitForContainerToBeHealthy(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char) WaitForContainerToBeHealthyReturn {
+	docker := clientHandle.DockerAPIClient()
+	ctx, cancel := context.WithCancel(contextHandle.Context())
+	defer cancel()
+
+	containerID := C.GoString(id)
+
+	// docker.Events() connects to the daemon in the background, so it may not be listening yet when we inspect the container below.
+	// Asking for events since just before the inspection means the daemon replays any change in the container's health that happens in between.
+	since := time.Now()
+
+	// We don't filter on the event action, as older daemons don't match health_status events with a filter like "event=health_status".
+	opts := types.EventsOptions{
+		Since: toTimestampFilter(since.Unix(), int64(since.Nanosecond())),
+		Filters: filtertypes.NewArgs(
+			filtertypes.Arg("type", "container"),
+			filtertypes.Arg("container", containerID),
+		),
+	}
+
+	eventsChan, errorsChan := docker.Events(ctx, opts)
+	state, err := inspectContainerHealth(ctx, docker, containerID)
+
+	if err != nil {
+		return newWaitForContainerToBeHealthyReturn("", "", 0, toError(err))
+	}
+
+	if state.Health == nil {
+		return newWaitForContainerToBeHealthyReturn("", "", 0, toError(ErrContainerHasNoHealthcheck))
+	}
+
+	if result, done := healthWaitResultFromState(state); done {
+		return result
+	}
+
+	for {
+		select {
+		case event := <-eventsChan:
+			switch event.Action {
+			case "health_status: healthy":
+				return newWaitForContainerToBeHealthyReturn(healthWaitOutcomeHealthy, "", 0, nil)
+
+			case "health_status: unhealthy":
+				// The event doesn't include the output of the failing health check, so we need to inspect the container to get it.
+				state, err := inspectContainerHealth(ctx, docker, containerID)
+
+				if err != nil {
+					return newWaitForContainerToBeHealthyReturn("", "", 0, toError(err))
+				}
+
+				return newWaitForContainerToBeHealthyReturn(healthWaitOutcomeUnhealthy, lastHealthcheckOutput(state.Health), 0, nil)
+
+			case "die":
+				exitCode, _ := strconv.ParseInt(event.Actor.Attributes["exitCode"], 10, 64)
+
+				return newWaitForContainerToBeHealthyReturn(healthWaitOutcomeExited, "", exitCode, nil)
+			}
+
+		case err := <-errorsChan:
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+
+			return newWaitForContainerToBeHealthyReturn("", "", 0, toError(err))
+		}
+	}
+}
+
+func inspectContainerHealth(ctx context.Context, docker *client.Client, containerID string) (*types.ContainerState, error) {
+	resp, err := docker.ContainerInspect(ctx, containerID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.State, nil
+}
+
+func healthWaitResultFromState(state *types.ContainerState) (WaitForContainerToBeHealthyReturn, bool) {
+	switch {
+	case state.Health.Status == types.Healthy:
+		return newWaitForContainerToBeHealthyReturn(healthWaitOutcomeHealthy, "", 0, nil), true
+	case state.Health.Status == types.Unhealthy:
+		return newWaitForContainerToBeHealthyReturn(healthWaitOutcomeUnhealthy, lastHealthcheckOutput(state.Health), 0, nil), true
+	case !state.Running && state.Status != "created":
+		return newWaitForContainerToBeHealthyReturn(healthWaitOutcomeExited, "", int64(state.ExitCode), nil), true
+	default:
+		return nil, false
+	}
+}
+
+func lastHealthcheckOutput(health *types.Health) string {
+	if health == nil || len(health.Log) == 0 {
+		return ""
+	}
+
+	return health.Log[len(health.Log)-1].Output
+}
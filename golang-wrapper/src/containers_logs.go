@@ -0,0 +1,101 @@
+// Copyright 2017-2022 Charles Korn.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	/*
+		#include "types.h"
+	*/
+	"C"
+	"io"
+	"strconv"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+//export StreamContainerLogs
+func StreamContainerLogs(
+	clientHandle DockerClientHandle,
+	contextHandle ContextHandle,
+	request *C.StreamContainerLogsRequest,
+	stdoutStreamHandle OutputStreamHandle,
+	stderrStreamHandle OutputStreamHandle,
+) Error {
+	defer stdoutStreamHandle.Close()
+	defer stderrStreamHandle.Close()
+
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+	containerID := C.GoString(request.ContainerID)
+
+	config, err := docker.ContainerInspect(ctx, containerID)
+
+	if err != nil {
+		return toError(err)
+	}
+
+	opts := containerLogsOptions(request)
+
+	var stdout, stderr io.Writer = io.Discard, io.Discard
+
+	if stdoutStreamHandle != 0 {
+		stdout = stdoutStreamHandle.OutputStream()
+		opts.ShowStdout = true
+	}
+
+	if stderrStreamHandle != 0 {
+		stderr = stderrStreamHandle.OutputStream()
+		opts.ShowStderr = true
+	}
+
+	responseBody, err := docker.ContainerLogs(ctx, containerID, opts)
+
+	if err != nil {
+		return toError(err)
+	}
+
+	defer responseBody.Close()
+
+	// Logs from containers with a TTY are not multiplexed: everything is written to stdout.
+	if config.Config.Tty {
+		_, err = io.Copy(stdout, responseBody)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, stderr, responseBody)
+	}
+
+	return toError(err)
+}
+
+func containerLogsOptions(request *C.StreamContainerLogsRequest) container.LogsOptions {
+	opts := container.LogsOptions{
+		Follow:     bool(request.Follow),
+		Timestamps: bool(request.ShowTimestamps),
+	}
+
+	if request.HaveTailLimit {
+		opts.Tail = strconv.FormatInt(int64(request.TailLines), 10)
+	}
+
+	if request.HaveSinceFilter {
+		opts.Since = toTimestampFilter(int64(request.SinceSeconds), int64(request.SinceNanoseconds))
+	}
+
+	if request.HaveUntilFilter {
+		opts.Until = toTimestampFilter(int64(request.UntilSeconds), int64(request.UntilNanoseconds))
+	}
+
+	return opts
+}
@@ -0,0 +1,211 @@
+// Copyright 2017-2022 Charles Korn.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	/*
+		#include "types.h"
+	*/
+	"C"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/versions"
+	"github.com/docker/docker/client"
+)
+
+func mountsForContainer(request *C.CreateContainerRequest) ([]mount.Mount, error) {
+	count := request.MountsCount
+	mounts := make([]mount.Mount, 0, count)
+
+	for i := 0; i < int(count); i++ {
+		requested := C.GetContainerMountArrayElement(request.Mounts, C.uint64_t(i))
+		m, err := fromContainerMount(requested)
+
+		if err != nil {
+			return nil, InvalidMountError{Index: i, Reason: err.Error()}
+		}
+
+		mounts = append(mounts, m)
+	}
+
+	return mounts, nil
+}
+
+func fromContainerMount(requested *C.ContainerMount) (mount.Mount, error) {
+	m := mount.Mount{
+		Type:        mount.Type(C.GoString(requested.Type)),
+		Source:      C.GoString(requested.Source),
+		Target:      C.GoString(requested.Target),
+		ReadOnly:    bool(requested.ReadOnly),
+		Consistency: mount.Consistency(C.GoString(requested.Consistency)),
+	}
+
+	if m.Target == "" {
+		return mount.Mount{}, errors.New("target path is required")
+	}
+
+	if err := validateConsistency(m.Consistency); err != nil {
+		return mount.Mount{}, err
+	}
+
+	switch m.Type {
+	case mount.TypeBind:
+		return fromBindMount(m, requested)
+	case mount.TypeVolume:
+		return fromVolumeMount(m, requested)
+	case mount.TypeTmpfs:
+		return fromTmpfsMount(m, requested)
+	default:
+		return mount.Mount{}, fmt.Errorf("unknown mount type '%s'", m.Type)
+	}
+}
+
+func fromBindMount(m mount.Mount, requested *C.ContainerMount) (mount.Mount, error) {
+	if m.Source == "" {
+		return mount.Mount{}, errors.New("bind mounts require a source path")
+	}
+
+	if hasVolumeOptions(requested) || hasTmpfsOptions(requested) {
+		return mount.Mount{}, errors.New("volume and tmpfs options cannot be used with bind mounts")
+	}
+
+	propagation := mount.Propagation(C.GoString(requested.BindPropagation))
+
+	if err := validatePropagation(propagation); err != nil {
+		return mount.Mount{}, err
+	}
+
+	if propagation != "" {
+		m.BindOptions = &mount.BindOptions{Propagation: propagation}
+	}
+
+	return m, nil
+}
+
+func fromVolumeMount(m mount.Mount, requested *C.ContainerMount) (mount.Mount, error) {
+	if hasBindOptions(requested) || hasTmpfsOptions(requested) {
+		return mount.Mount{}, errors.New("bind and tmpfs options cannot be used with volume mounts")
+	}
+
+	if !hasVolumeOptions(requested) {
+		return m, nil
+	}
+
+	m.VolumeOptions = &mount.VolumeOptions{
+		NoCopy:  bool(requested.VolumeNoCopy),
+		Labels:  fromStringPairs(requested.VolumeLabels, requested.VolumeLabelsCount),
+		Subpath: C.GoString(requested.VolumeSubpath),
+	}
+
+	volumeDriver := C.GoString(requested.VolumeDriver)
+
+	if volumeDriver != "" || requested.VolumeDriverOptionsCount > 0 {
+		m.VolumeOptions.DriverConfig = &mount.Driver{
+			Name:    volumeDriver,
+			Options: fromStringPairs(requested.VolumeDriverOptions, requested.VolumeDriverOptionsCount),
+		}
+	}
+
+	return m, nil
+}
+
+func fromTmpfsMount(m mount.Mount, requested *C.ContainerMount) (mount.Mount, error) {
+	if m.Source != "" {
+		return mount.Mount{}, errors.New("tmpfs mounts cannot have a source")
+	}
+
+	if hasBindOptions(requested) || hasVolumeOptions(requested) {
+		return mount.Mount{}, errors.New("bind and volume options cannot be used with tmpfs mounts")
+	}
+
+	if requested.TmpfsSizeInBytes < 0 {
+		return mount.Mount{}, errors.New("tmpfs size cannot be negative")
+	}
+
+	if requested.TmpfsMode < 0 || requested.TmpfsMode > 0o7777 {
+		return mount.Mount{}, fmt.Errorf("tmpfs mode %o is not a valid file mode", int32(requested.TmpfsMode))
+	}
+
+	if hasTmpfsOptions(requested) {
+		m.TmpfsOptions = &mount.TmpfsOptions{
+			SizeBytes: int64(requested.TmpfsSizeInBytes),
+			Mode:      os.FileMode(requested.TmpfsMode),
+		}
+	}
+
+	return m, nil
+}
+
+func hasBindOptions(requested *C.ContainerMount) bool {
+	return C.GoString(requested.BindPropagation) != ""
+}
+
+func hasVolumeOptions(requested *C.ContainerMount) bool {
+	return C.GoString(requested.VolumeDriver) != "" ||
+		requested.VolumeDriverOptionsCount > 0 ||
+		requested.VolumeLabelsCount > 0 ||
+		bool(requested.VolumeNoCopy) ||
+		C.GoString(requested.VolumeSubpath) != ""
+}
+
+func hasTmpfsOptions(requested *C.ContainerMount) bool {
+	return requested.TmpfsSizeInBytes != 0 || requested.TmpfsMode != 0
+}
+
+func validateConsistency(consistency mount.Consistency) error {
+	switch consistency {
+	case "", mount.ConsistencyFull, mount.ConsistencyCached, mount.ConsistencyDelegated, mount.ConsistencyDefault:
+		return nil
+	default:
+		return fmt.Errorf("unknown consistency '%s'", consistency)
+	}
+}
+
+func validatePropagation(propagation mount.Propagation) error {
+	if propagation == "" {
+		return nil
+	}
+
+	for _, p := range mount.Propagations {
+		if propagation == p {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown bind propagation '%s'", propagation)
+}
+
+// Daemons older than API version 1.45 ignore volume subpaths and mount the whole volume instead, so refuse to use them.
+func checkMountsSupportedByDaemon(ctx context.Context, docker *client.Client, mounts []mount.Mount) error {
+	for _, m := range mounts {
+		if m.VolumeOptions == nil || m.VolumeOptions.Subpath == "" {
+			continue
+		}
+
+		docker.NegotiateAPIVersion(ctx)
+
+		if versions.LessThan(docker.ClientVersion(), "1.45") {
+			return VolumeSubpathNotSupportedError{APIVersion: docker.ClientVersion()}
+		}
+
+		return nil
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+// Copyright 2017-2022 Charles Korn.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	/*
+		#include "types.h"
+	*/
+	"C"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"unsafe"
+
+	"github.com/docker/docker/api/types"
+)
+
+//export StreamContainerStats
+func StreamContainerStats(
+	clientHandle DockerClientHandle,
+	contextHandle ContextHandle,
+	id *C.char,
+	onStats ContainerStatsCallback,
+	callbackUserData unsafe.Pointer,
+) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	response, err := docker.ContainerStats(ctx, C.GoString(id), true)
+
+	if err != nil {
+		return toError(err)
+	}
+
+	defer response.Body.Close()
+
+	decoder := json.NewDecoder(response.Body)
+	calculator := containerStatsCalculator{isWindows: response.OSType == "windows"}
+
+	for {
+		var stats types.StatsJSON
+
+		if err := decoder.Decode(&stats); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return toError(err)
+		}
+
+		if err := notifyContainerStats(calculator.sample(stats), onStats, callbackUserData); err != nil {
+			return toError(err)
+		}
+	}
+}
+
+func notifyContainerStats(sample ContainerStatsSample, onStats ContainerStatsCallback, callbackUserData unsafe.Pointer) error {
+	defer C.FreeContainerStatsSample(sample)
+
+	if !invokeContainerStatsCallback(onStats, callbackUserData, sample) {
+		return ErrStatsCallbackFailed
+	}
+
+	return nil
+}
+
+type containerIOTotals struct {
+	networkReceived    uint64
+	networkTransmitted uint64
+	blockIORead        uint64
+	blockIOWritten     uint64
+}
+
+func (t containerIOTotals) since(previous containerIOTotals) containerIOTotals {
+	return containerIOTotals{
+		networkReceived:    counterDelta(t.networkReceived, previous.networkReceived),
+		networkTransmitted: counterDelta(t.networkTransmitted, previous.networkTransmitted),
+		blockIORead:        counterDelta(t.blockIORead, previous.blockIORead),
+		blockIOWritten:     counterDelta(t.blockIOWritten, previous.blockIOWritten),
+	}
+}
+
+// If the container restarted between samples, its counters are reset, so the whole of the current value is new.
+func counterDelta(current uint64, previous uint64) uint64 {
+	if current < previous {
+		return current
+	}
+
+	return current - previous
+}
+
+type containerStatsCalculator struct {
+	isWindows bool
+	previous  *containerIOTotals
+}
+
+func (c *containerStatsCalculator) sample(stats types.StatsJSON) ContainerStatsSample {
+	totals := c.ioTotals(stats)
+	deltas := containerIOTotals{}
+
+	if c.previous != nil {
+		deltas = totals.since(*c.previous)
+	}
+
+	c.previous = &totals
+
+	return newContainerStatsSample(
+		stats.Read.UnixMilli(),
+		c.cpuPercentage(stats),
+		int64(c.memoryUsage(stats)),
+		int64(stats.MemoryStats.Limit),
+		int64(totals.networkReceived),
+		int64(totals.networkTransmitted),
+		int64(deltas.networkReceived),
+		int64(deltas.networkTransmitted),
+		int64(totals.blockIORead),
+		int64(totals.blockIOWritten),
+		int64(deltas.blockIORead),
+		int64(deltas.blockIOWritten),
+	)
+}
+
+// The calculations below are based on those in github.com/docker/cli/cli/command/container/stats_helpers.go.
+func (c *containerStatsCalculator) cpuPercentage(stats types.StatsJSON) float64 {
+	if c.isWindows {
+		possibleIntervals := uint64(stats.Read.Sub(stats.PreRead).Nanoseconds()) / 100 * uint64(stats.NumProcs)
+		intervalsUsed := stats.CPUStats.CPUUsage.TotalUsage - stats.PreCPUStats.CPUUsage.TotalUsage
+
+		if possibleIntervals == 0 {
+			return 0
+		}
+
+		return float64(intervalsUsed) / float64(possibleIntervals) * 100.0
+	}
+
+	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage) - float64(stats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(stats.CPUStats.SystemUsage) - float64(stats.PreCPUStats.SystemUsage)
+	onlineCPUs := float64(stats.CPUStats.OnlineCPUs)
+
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(stats.CPUStats.CPUUsage.PercpuUsage))
+	}
+
+	if systemDelta <= 0 || cpuDelta <= 0 {
+		return 0
+	}
+
+	return (cpuDelta / systemDelta) * onlineCPUs * 100.0
+}
+
+func (c *containerStatsCalculator) memoryUsage(stats types.StatsJSON) uint64 {
+	memory := stats.MemoryStats
+
+	if c.isWindows {
+		return memory.PrivateWorkingSet
+	}
+
+	// cgroup v1 reports cached memory as total_inactive_file, cgroup v2 reports it as inactive_file.
+	if v, isCgroup1 := memory.Stats["total_inactive_file"]; isCgroup1 && v < memory.Usage {
+		return memory.Usage - v
+	}
+
+	if v := memory.Stats["inactive_file"]; v < memory.Usage {
+		return memory.Usage - v
+	}
+
+	return memory.Usage
+}
+
+func (c *containerStatsCalculator) ioTotals(stats types.StatsJSON) containerIOTotals {
+	totals := containerIOTotals{}
+
+	for _, network := range stats.Networks {
+		totals.networkReceived += network.RxBytes
+		totals.networkTransmitted += network.TxBytes
+	}
+
+	if c.isWindows {
+		totals.blockIORead = stats.StorageStats.ReadSizeBytes
+		totals.blockIOWritten = stats.StorageStats.WriteSizeBytes
+
+		return totals
+	}
+
+	for _, entry := range stats.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			totals.blockIORead += entry.Value
+		case "write":
+			totals.blockIOWritten += entry.Value
+		}
+	}
+
+	return totals
+}
@@ -0,0 +1,272 @@
+// Copyright 2017-2022 Charles Korn.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	/*
+		#include "types.h"
+	*/
+	"C"
+	"archive/tar"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/archive"
+	"github.com/docker/docker/pkg/idtools"
+	"github.com/moby/patternmatcher"
+)
+
+//export UploadPathsToContainer
+func UploadPathsToContainer(
+	clientHandle DockerClientHandle,
+	contextHandle ContextHandle,
+	containerID *C.char,
+	request *C.UploadPathsToContainerRequest,
+	destinationPath *C.char,
+) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	paths, err := uploadPathsFromRequest(request)
+
+	if err != nil {
+		return toError(err)
+	}
+
+	writer := uploadArchiveWriter{followSymlinks: bool(request.FollowSymlinks)}
+	archiveReader, archiveWriter := io.Pipe()
+
+	// Closing the read end stops the goroutine below if the daemon stops reading the archive early.
+	defer archiveReader.Close()
+
+	go func() {
+		archiveWriter.CloseWithError(writer.write(archiveWriter, paths))
+	}()
+
+	opts := types.CopyToContainerOptions{
+		AllowOverwriteDirWithFile: false,
+	}
+
+	if err := docker.CopyToContainer(ctx, C.GoString(containerID), C.GoString(destinationPath), archiveReader, opts); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
+
+type uploadPath struct {
+	localPath     string
+	containerPath string
+	excludes      *patternmatcher.PatternMatcher
+	owner         *idtools.Identity
+	fileMode      *int64
+	directoryMode *int64
+}
+
+func uploadPathsFromRequest(request *C.UploadPathsToContainerRequest) ([]uploadPath, error) {
+	paths := make([]uploadPath, 0, request.PathsCount)
+
+	for i := 0; i < int(request.PathsCount); i++ {
+		requested := C.GetUploadPathArrayElement(request.Paths, C.uint64_t(i))
+		localPath := filepath.Clean(C.GoString(requested.LocalPath))
+		excludes, err := patternmatcher.New(fromStringArray(requested.ExcludePatterns, requested.ExcludePatternsCount))
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern for '%s': %w", localPath, err)
+		}
+
+		p := uploadPath{
+			localPath:     localPath,
+			containerPath: strings.TrimPrefix(path.Clean("/"+C.GoString(requested.ContainerPath)), "/"),
+			excludes:      excludes,
+		}
+
+		if p.containerPath == "" {
+			p.containerPath = filepath.Base(localPath)
+		}
+
+		if requested.OverrideOwner {
+			p.owner = &idtools.Identity{UID: int(requested.Owner), GID: int(requested.Group)}
+		}
+
+		if requested.OverrideFileMode {
+			mode := int64(requested.FileMode)
+			p.fileMode = &mode
+		}
+
+		if requested.OverrideDirectoryMode {
+			mode := int64(requested.DirectoryMode)
+			p.directoryMode = &mode
+		}
+
+		paths = append(paths, p)
+	}
+
+	return paths, nil
+}
+
+func (p uploadPath) applyOverrides(header *tar.Header) {
+	if p.owner != nil {
+		header.Uid = p.owner.UID
+		header.Gid = p.owner.GID
+		header.Uname = ""
+		header.Gname = ""
+	}
+
+	var mode *int64
+
+	switch header.Typeflag {
+	case tar.TypeReg:
+		mode = p.fileMode
+	case tar.TypeDir:
+		mode = p.directoryMode
+	}
+
+	if mode != nil {
+		header.Mode = (header.Mode &^ 0o7777) | (*mode & 0o7777)
+	}
+}
+
+type uploadArchiveWriter struct {
+	tar            *tar.Writer
+	followSymlinks bool
+}
+
+func (w *uploadArchiveWriter) write(destination io.Writer, paths []uploadPath) error {
+	w.tar = tar.NewWriter(destination)
+
+	for _, p := range paths {
+		if err := w.add(p, p.localPath, p.containerPath, nil, patternmatcher.MatchInfo{}, true); err != nil {
+			return err
+		}
+	}
+
+	return w.tar.Close()
+}
+
+// matchInfo holds the exclude pattern results for localPath, so that the entries of a directory are matched using the results for their parent.
+// includeSelf is false for excluded directories that are only walked because an exception pattern (eg. "!dir/file") may match something inside them.
+func (w *uploadArchiveWriter) add(p uploadPath, localPath string, archivePath string, parentDirectories []os.FileInfo, matchInfo patternmatcher.MatchInfo, includeSelf bool) error {
+	info, err := w.stat(localPath)
+
+	if err != nil {
+		return err
+	}
+
+	if includeSelf {
+		if err := w.addHeader(p, localPath, archivePath, info); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case info.Mode().IsRegular():
+		return w.addFileContents(localPath, info.Size())
+	case info.IsDir():
+		return w.addDirectoryContents(p, localPath, archivePath, append(parentDirectories, info), matchInfo)
+	default:
+		return nil
+	}
+}
+
+func (w *uploadArchiveWriter) stat(localPath string) (os.FileInfo, error) {
+	if w.followSymlinks {
+		return os.Stat(localPath)
+	}
+
+	return os.Lstat(localPath)
+}
+
+func (w *uploadArchiveWriter) addHeader(p uploadPath, localPath string, archivePath string, info os.FileInfo) error {
+	link := ""
+
+	if info.Mode()&os.ModeSymlink != 0 {
+		var err error
+
+		if link, err = os.Readlink(localPath); err != nil {
+			return err
+		}
+	}
+
+	header, err := archive.FileInfoHeader(archivePath, info, link)
+
+	if err != nil {
+		return err
+	}
+
+	p.applyOverrides(header)
+
+	return w.tar.WriteHeader(header)
+}
+
+func (w *uploadArchiveWriter) addFileContents(localPath string, size int64) error {
+	f, err := os.Open(localPath)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	_, err = io.CopyN(w.tar, f, size)
+
+	return err
+}
+
+func (w *uploadArchiveWriter) addDirectoryContents(p uploadPath, localPath string, archivePath string, directories []os.FileInfo, matchInfo patternmatcher.MatchInfo) error {
+	current := directories[len(directories)-1]
+
+	for _, parent := range directories[:len(directories)-1] {
+		if os.SameFile(parent, current) {
+			return SymlinkLoopError{Path: localPath}
+		}
+	}
+
+	entries, err := os.ReadDir(localPath)
+
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		entryLocalPath := filepath.Join(localPath, entry.Name())
+		relativePath, err := filepath.Rel(p.localPath, entryLocalPath)
+
+		if err != nil {
+			return err
+		}
+
+		excluded, entryMatchInfo, err := p.excludes.MatchesUsingParentResults(filepath.ToSlash(relativePath), matchInfo)
+
+		if err != nil {
+			return err
+		}
+
+		if excluded && !(entry.IsDir() && p.excludes.Exclusions()) {
+			continue
+		}
+
+		if err := w.add(p, entryLocalPath, path.Join(archivePath, entry.Name()), directories, entryMatchInfo, !excluded); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
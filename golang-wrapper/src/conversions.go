@@ -14,7 +14,18 @@
 
 package main
 
-import "fmt"
+import (
+	/*
+		#include "types.h"
+	*/
+	"C"
+	"fmt"
+	"time"
+
+	"github.com/containerd/containerd/platforms"
+	"github.com/docker/docker/api/types/container"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
 
 func toError(err error) Error {
 	if err == nil {
@@ -26,3 +37,54 @@ func toError(err error) Error {
 		err.Error(),
 	)
 }
+
+func toTimestampFilter(seconds int64, nanoseconds int64) string {
+	return time.Unix(seconds, nanoseconds).Format(time.RFC3339Nano)
+}
+
+// parseTimestamp converts a timestamp returned by the daemon to milliseconds since the Unix epoch,
+// returning 0 for timestamps that are missing or unset (such as the finish time of a running container).
+func parseTimestamp(value string) int64 {
+	t, err := time.Parse(time.RFC3339Nano, value)
+
+	if err != nil || t.IsZero() {
+		return 0
+	}
+
+	return t.UnixMilli()
+}
+
+func parsePlatform(specifier string) (*ocispec.Platform, error) {
+	if specifier == "" {
+		return nil, nil
+	}
+
+	p, err := platforms.Parse(specifier)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+func toConsoleSize(height int64, width int64) ([2]uint, error) {
+	if height < 0 || width < 0 {
+		return [2]uint{}, InvalidConsoleSizeError{Height: height, Width: width}
+	}
+
+	return [2]uint{uint(height), uint(width)}, nil
+}
+
+func toResizeOptions(height C.int64_t, width C.int64_t) (container.ResizeOptions, error) {
+	size, err := toConsoleSize(int64(height), int64(width))
+
+	if err != nil {
+		return container.ResizeOptions{}, err
+	}
+
+	return container.ResizeOptions{
+		Height: size[0],
+		Width:  size[1],
+	}, nil
+}
@@ -21,10 +21,12 @@ var (
 	ErrProgressCallbackFailed    = ProgressCallbackFailedError{}
 	ErrReadyCallbackFailed       = ReadyCallbackFailedError{}
 	ErrEventCallbackFailed       = EventCallbackFailedError{}
+	ErrStatsCallbackFailed       = StatsCallbackFailedError{}
 	ErrInvalidOutputStreamHandle = InvalidOutputStreamHandleError{}
 	ErrInvalidInputStreamHandle  = InvalidInputStreamHandleError{}
 	ErrBuildKitNotSupported      = BuildKitNotSupportedError{}
 	ErrInvalidContextHandle      = InvalidContextHandleError{}
+	ErrContainerHasNoHealthcheck = ContainerHasNoHealthcheckError{}
 )
 
 type InvalidDockerClientHandleError struct{}
@@ -51,6 +53,12 @@ func (e EventCallbackFailedError) Error() string {
 	return "event callback failed"
 }
 
+type StatsCallbackFailedError struct{}
+
+func (e StatsCallbackFailedError) Error() string {
+	return "stats callback failed"
+}
+
 type InvalidOutputStreamHandleError struct{}
 
 func (e InvalidOutputStreamHandleError) Error() string {
@@ -77,6 +85,72 @@ func (e InvalidBuilderVersionError) Error() string {
 	return fmt.Sprintf("unknown builder version '%s'", e.InvalidVersion)
 }
 
+type SymlinkLoopError struct {
+	Path string
+}
+
+func (e SymlinkLoopError) Error() string {
+	return fmt.Sprintf("'%s' is part of a symlink loop", e.Path)
+}
+
+type InvalidContainerConfigurationError struct {
+	Reason string
+}
+
+func (e InvalidContainerConfigurationError) Error() string {
+	return fmt.Sprintf("invalid container configuration: %s", e.Reason)
+}
+
+type InvalidMountError struct {
+	Index  int
+	Reason string
+}
+
+func (e InvalidMountError) Error() string {
+	return fmt.Sprintf("mount %v is invalid: %s", e.Index, e.Reason)
+}
+
+type VolumeSubpathNotSupportedError struct {
+	APIVersion string
+}
+
+func (e VolumeSubpathNotSupportedError) Error() string {
+	return fmt.Sprintf("volume subpaths require Docker API version 1.45 or later, but the daemon only supports version %s", e.APIVersion)
+}
+
+type InvalidConsoleSizeError struct {
+	Height int64
+	Width  int64
+}
+
+func (e InvalidConsoleSizeError) Error() string {
+	return fmt.Sprintf("console size %vx%v is invalid: height and width must not be negative", e.Width, e.Height)
+}
+
+type ContainerHasNoHealthcheckError struct{}
+
+func (e ContainerHasNoHealthcheckError) Error() string {
+	return "container does not have a health check"
+}
+
+type PushDigestNotReportedError struct {
+	Reference string
+}
+
+func (e PushDigestNotReportedError) Error() string {
+	return fmt.Sprintf("the daemon did not report the digest of the pushed image '%s'", e.Reference)
+}
+
+type ImagePlatformMismatchError struct {
+	Reference         string
+	RequestedPlatform string
+	ActualPlatform    string
+}
+
+func (e ImagePlatformMismatchError) Error() string {
+	return fmt.Sprintf("image '%s' was pulled for platform '%s', but its platform is '%s'", e.Reference, e.RequestedPlatform, e.ActualPlatform)
+}
+
 type InvalidContextHandleError struct{}
 
 func (e InvalidContextHandleError) Error() string {
@@ -21,7 +21,6 @@ import (
 	"C"
 	"errors"
 	"io"
-	"time"
 	"unsafe"
 
 	"github.com/docker/docker/api/types"
@@ -45,11 +44,11 @@ func StreamEvents(
 	}
 
 	if request.HaveSinceFilter {
-		opts.Since = time.Unix(int64(request.SinceSeconds), int64(request.SinceNanoseconds)).Format(time.RFC3339Nano)
+		opts.Since = toTimestampFilter(int64(request.SinceSeconds), int64(request.SinceNanoseconds))
 	}
 
 	if request.HaveUntilFilter {
-		opts.Until = time.Unix(int64(request.UntilSeconds), int64(request.UntilNanoseconds)).Format(time.RFC3339Nano)
+		opts.Until = toTimestampFilter(int64(request.UntilSeconds), int64(request.UntilNanoseconds))
 	}
 
 	eventsChan, errorsChan := docker.Events(ctx, opts)
@@ -72,8 +71,8 @@ func StreamEvents(
 
 func notifyEvent(e events.Message, onEvent EventCallback, callbackUserData unsafe.Pointer) error {
 	event := newEvent(
-		e.Type,
-		e.Action,
+		string(e.Type),
+		string(e.Action),
 		newActor(e.Actor.ID, toStringPairs(e.Actor.Attributes)),
 		e.Scope,
 		e.TimeNano,
@@ -49,6 +49,16 @@ func CreateExec(clientHandle DockerClientHandle, contextHandle ContextHandle, re
 		config.Env = fromStringArray(request.EnvironmentVariables, request.EnvironmentVariablesCount)
 	}
 
+	if request.InitialConsoleHeight != 0 || request.InitialConsoleWidth != 0 {
+		consoleSize, err := toConsoleSize(int64(request.InitialConsoleHeight), int64(request.InitialConsoleWidth))
+
+		if err != nil {
+			return newCreateExecReturn(nil, toError(err))
+		}
+
+		config.ConsoleSize = &consoleSize
+	}
+
 	resp, err := docker.ContainerExecCreate(ctx, containerID, config)
 
 	if err != nil {
@@ -148,3 +158,21 @@ func StartAndAttachToExec(
 
 	return nil
 }
+
+//export ResizeExecTTY
+func ResizeExecTTY(clientHandle DockerClientHandle, contextHandle ContextHandle, id *C.char, height C.int64_t, width C.int64_t) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	opts, err := toResizeOptions(height, width)
+
+	if err != nil {
+		return toError(err)
+	}
+
+	if err := docker.ContainerExecResize(ctx, C.GoString(id), opts); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
@@ -20,9 +20,12 @@ import (
 	*/
 	"C"
 	"context"
+	"sort"
+	"time"
 
 	"github.com/docker/distribution/reference"
-	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	imagetypes "github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/client"
 )
 
@@ -31,7 +34,7 @@ func DeleteImage(clientHandle DockerClientHandle, contextHandle ContextHandle, r
 	docker := clientHandle.DockerAPIClient()
 	ctx := contextHandle.Context()
 
-	opts := types.ImageRemoveOptions{
+	opts := imagetypes.RemoveOptions{
 		Force:         bool(force),
 		PruneChildren: true,
 	}
@@ -73,6 +76,105 @@ func getImageReference(ctx context.Context, docker *client.Client, reference str
 	return newImageReference(dockerResponse.ID), nil
 }
 
+//export InspectImage
+func InspectImage(clientHandle DockerClientHandle, contextHandle ContextHandle, ref *C.char) InspectImageReturn {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	dockerResponse, _, err := docker.ImageInspectWithRaw(ctx, C.GoString(ref))
+
+	if err != nil {
+		return newInspectImageReturn(nil, toError(err))
+	}
+
+	result := newImageInspectionResult(
+		dockerResponse.ID,
+		dockerResponse.RepoTags,
+		dockerResponse.RepoDigests,
+		parseTimestamp(dockerResponse.Created),
+		dockerResponse.Size,
+		dockerResponse.Os,
+		dockerResponse.Architecture,
+		dockerResponse.Variant,
+		dockerResponse.Author,
+		toImageConfig(dockerResponse.Config),
+		dockerResponse.RootFS.Type,
+		dockerResponse.RootFS.Layers,
+	)
+
+	return newInspectImageReturn(result, nil)
+}
+
+func toImageConfig(config *container.Config) ImageConfig {
+	if config == nil {
+		return newImageConfig(nil, nil, nil, "", "", nil, nil, nil, nil, "")
+	}
+
+	exposedPorts := make([]string, 0, len(config.ExposedPorts))
+
+	for port := range config.ExposedPorts {
+		exposedPorts = append(exposedPorts, string(port))
+	}
+
+	sort.Strings(exposedPorts)
+
+	volumes := make([]string, 0, len(config.Volumes))
+
+	for volume := range config.Volumes {
+		volumes = append(volumes, volume)
+	}
+
+	sort.Strings(volumes)
+
+	return newImageConfig(
+		config.Env,
+		config.Entrypoint,
+		config.Cmd,
+		config.WorkingDir,
+		config.User,
+		exposedPorts,
+		volumes,
+		toStringPairs(config.Labels),
+		toContainerHealthcheckConfig(config.Healthcheck),
+		config.StopSignal,
+	)
+}
+
+//export ListImages
+func ListImages(clientHandle DockerClientHandle, contextHandle ContextHandle, request *C.ListImagesRequest) ListImagesReturn {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	opts := imagetypes.ListOptions{
+		All:     bool(request.All),
+		Filters: toFilterArgs(request.Filters, request.FiltersCount),
+	}
+
+	dockerResponse, err := docker.ImageList(ctx, opts)
+
+	if err != nil {
+		return newListImagesReturn(nil, toError(err))
+	}
+
+	images := make([]ImageSummary, 0, len(dockerResponse))
+
+	for _, i := range dockerResponse {
+		summary := newImageSummary(
+			i.ID,
+			i.ParentID,
+			i.RepoTags,
+			i.RepoDigests,
+			i.Size,
+			time.Unix(i.Created, 0).UnixMilli(),
+			toStringPairs(i.Labels),
+		)
+
+		images = append(images, summary)
+	}
+
+	return newListImagesReturn(images, nil)
+}
+
 //export ValidateImageTag
 func ValidateImageTag(tag *C.char) Error {
 	_, err := reference.ParseNormalizedNamed(C.GoString(tag))
@@ -0,0 +1,144 @@
+// Copyright 2017-2022 Charles Korn.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	/*
+		#include "types.h"
+	*/
+	"C"
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/docker/docker/pkg/jsonmessage"
+)
+
+var errLoadResponseNotJSON = errors.New("the daemon returned an unexpected response to the image load request")
+
+//export SaveImages
+func SaveImages(clientHandle DockerClientHandle, contextHandle ContextHandle, request *C.SaveImagesRequest, outputStreamHandle OutputStreamHandle) Error {
+	defer outputStreamHandle.Close()
+
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	content, err := docker.ImageSave(ctx, fromStringArray(request.References, request.ReferencesCount))
+
+	if err != nil {
+		return toError(err)
+	}
+
+	defer content.Close()
+
+	if _, err := io.Copy(outputStreamHandle.OutputStream(), content); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
+
+//export SaveImagesToFile
+func SaveImagesToFile(clientHandle DockerClientHandle, contextHandle ContextHandle, request *C.SaveImagesRequest, destinationPath *C.char) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	content, err := docker.ImageSave(ctx, fromStringArray(request.References, request.ReferencesCount))
+
+	if err != nil {
+		return toError(err)
+	}
+
+	defer content.Close()
+
+	if err := writeToFile(C.GoString(destinationPath), content); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
+
+//export LoadImages
+func LoadImages(clientHandle DockerClientHandle, contextHandle ContextHandle, inputStreamHandle InputStreamHandle) LoadImagesReturn {
+	// We don't need to close inputStreamHandle - the Kotlin code should do that when there is no more input to stream.
+	input := inputStreamHandle.InputStream()
+
+	if input == nil {
+		return newLoadImagesReturn(nil, toError(ErrInvalidInputStreamHandle))
+	}
+
+	return loadImages(clientHandle, contextHandle, input)
+}
+
+//export LoadImagesFromFile
+func LoadImagesFromFile(clientHandle DockerClientHandle, contextHandle ContextHandle, sourcePath *C.char) LoadImagesReturn {
+	f, err := os.Open(C.GoString(sourcePath))
+
+	if err != nil {
+		return newLoadImagesReturn(nil, toError(err))
+	}
+
+	defer f.Close()
+
+	return loadImages(clientHandle, contextHandle, f)
+}
+
+func loadImages(clientHandle DockerClientHandle, contextHandle ContextHandle, input io.Reader) LoadImagesReturn {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	resp, err := docker.ImageLoad(ctx, input, false)
+
+	if err != nil {
+		return newLoadImagesReturn(nil, toError(err))
+	}
+
+	defer resp.Body.Close()
+
+	if !resp.JSON {
+		return newLoadImagesReturn(nil, toError(errLoadResponseNotJSON))
+	}
+
+	var references []string
+
+	err = parsePullResponseBody(resp.Body, func(message jsonmessage.JSONMessage) error {
+		if ref, ok := loadedImageReference(message.Stream); ok {
+			references = append(references, ref)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return newLoadImagesReturn(nil, toError(err))
+	}
+
+	return newLoadImagesReturn(references, nil)
+}
+
+// loadedImageReference extracts the image reference from messages like "Loaded image: alpine:3.18"
+// (for tagged images) or "Loaded image ID: sha256:..." (for untagged images).
+func loadedImageReference(message string) (string, bool) {
+	message = strings.TrimSpace(message)
+
+	for _, prefix := range []string{"Loaded image: ", "Loaded image ID: "} {
+		if strings.HasPrefix(message, prefix) {
+			return strings.TrimPrefix(message, prefix), true
+		}
+	}
+
+	return "", false
+}
@@ -130,6 +130,12 @@ func createSession(ctx context.Context, request *imageBuildRequest, tracer *buil
 		return nil, fmt.Errorf("failed to create session: %w", err)
 	}
 
+	fileSyncProvider, err := createFileSyncProvider(request)
+
+	if err != nil {
+		return nil, err
+	}
+
 	secretsProvider, err := createSecretsProvider(request)
 
 	if err != nil {
@@ -148,7 +154,7 @@ func createSession(ctx context.Context, request *imageBuildRequest, tracer *buil
 		return nil, err
 	}
 
-	sess.Allow(createFileSyncProvider(request))
+	sess.Allow(fileSyncProvider)
 	sess.Allow(secretsProvider)
 	sess.Allow(authProvider)
 	sess.Allow(sshProvider)
@@ -156,18 +162,29 @@ func createSession(ctx context.Context, request *imageBuildRequest, tracer *buil
 	return sess, nil
 }
 
-func createFileSyncProvider(request *imageBuildRequest) session.Attachable {
-	dockerfileDir := filepath.Dir(request.PathToDockerfile)
+func createFileSyncProvider(request *imageBuildRequest) (session.Attachable, error) {
+	contextDir, err := fsutil.NewFS(request.ContextDirectory)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not open build context directory: %w", err)
+	}
+
+	contextDir, err = fsutil.NewFilterFS(contextDir, &fsutil.FilterOpt{Map: resetUIDAndGID})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not open build context directory: %w", err)
+	}
+
+	dockerfileDir, err := fsutil.NewFS(filepath.Dir(request.PathToDockerfile))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not open Dockerfile directory: %w", err)
+	}
 
 	return filesync.NewFSSyncProvider(filesync.StaticDirSource{
-		"context": {
-			Dir: request.ContextDirectory,
-			Map: resetUIDAndGID,
-		},
-		"dockerfile": {
-			Dir: dockerfileDir,
-		},
-	})
+		"context":    contextDir,
+		"dockerfile": dockerfileDir,
+	}), nil
 }
 
 func createSecretsProvider(request *imageBuildRequest) (session.Attachable, error) {
@@ -182,7 +199,7 @@ func createSecretsProvider(request *imageBuildRequest) (session.Attachable, erro
 
 func createAuthProvider(tracer *buildKitBuildTracer, configFile *configfile.ConfigFile) (session.Attachable, error) {
 	// At the time of writing, this Writer is just used for warning messages when loading the local config file, so we can safely ignore these messages.
-	authProvider := authprovider.NewDockerAuthProvider(configFile)
+	authProvider := authprovider.NewDockerAuthProvider(configFile, nil)
 	authProviderLogger, authProviderSupportsLogging := authProvider.(loggingAttachable)
 
 	if !authProviderSupportsLogging {
@@ -364,7 +381,13 @@ func (t *buildKitBuildTracer) run() error {
 	output := t.outputStreamHandle.OutputStream()
 
 	// We deliberately don't use the build operation's context as the context below - otherwise, error messages might not be printed after the context is cancelled.
-	_, err := progressui.DisplaySolveStatus(context.Background(), nil, output, t.displayCh)
+	display, err := progressui.NewDisplay(output, progressui.PlainMode)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = display.UpdateFrom(context.Background(), t.displayCh)
 
 	return err
 }
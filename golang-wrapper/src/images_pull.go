@@ -27,21 +27,32 @@ import (
 	"strings"
 	"unsafe"
 
+	"github.com/containerd/containerd/platforms"
 	"github.com/docker/cli/cli/trust"
 	"github.com/docker/distribution/reference"
 	"github.com/docker/docker/api/types"
+	imagetypes "github.com/docker/docker/api/types/image"
 	registrytypes "github.com/docker/docker/api/types/registry"
 	"github.com/docker/docker/client"
 	"github.com/docker/docker/pkg/jsonmessage"
 	"github.com/docker/docker/registry"
 	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 )
 
+var errAllTagsWithTagOrDigest = errors.New("a tag or digest cannot be used when pulling all tags of an image")
+
+// PullImage pulls the given image and returns a reference to it.
+//
+// If allTags is true, every tagged image in the repository is pulled and returned in AllTagsImages instead of Response.
+//
 //export PullImage
 func PullImage(
 	clientHandle DockerClientHandle,
 	contextHandle ContextHandle,
 	ref *C.char,
+	platform *C.char,
+	allTags C.bool,
 	onProgressUpdate PullImageProgressCallback,
 	callbackUserData unsafe.Pointer,
 ) PullImageReturn {
@@ -51,7 +62,17 @@ func PullImage(
 	distributionRef, err := reference.ParseNormalizedNamed(C.GoString(ref))
 
 	if err != nil {
-		return newPullImageReturn(nil, toError(err))
+		return newPullImageReturn(nil, nil, toError(err))
+	}
+
+	if bool(allTags) && !reference.IsNameOnly(distributionRef) {
+		return newPullImageReturn(nil, nil, toError(errAllTagsWithTagOrDigest))
+	}
+
+	requestedPlatform, err := parsePlatform(C.GoString(platform))
+
+	if err != nil {
+		return newPullImageReturn(nil, nil, toError(err))
 	}
 
 	imgRefAndAuth, err := trust.GetImageReferencesAndAuth(
@@ -61,30 +82,34 @@ func PullImage(
 	)
 
 	if err != nil {
-		return newPullImageReturn(nil, toError(err))
+		return newPullImageReturn(nil, nil, toError(err))
 	}
 
 	encodedAuth, err := registrytypes.EncodeAuthConfig(*imgRefAndAuth.AuthConfig())
 
 	if err != nil {
-		return newPullImageReturn(nil, toError(err))
+		return newPullImageReturn(nil, nil, toError(err))
 	}
 
-	options := types.ImagePullOptions{
+	options := imagetypes.PullOptions{
 		RegistryAuth: encodedAuth,
-		All:          false,
+		All:          bool(allTags),
+	}
+
+	if requestedPlatform != nil {
+		options.Platform = platforms.Format(*requestedPlatform)
 	}
 
 	cleanedReference := reference.FamiliarString(imgRefAndAuth.Reference())
 	responseBody, err := docker.ImagePull(ctx, cleanedReference, options)
 
 	if err != nil {
-		return newPullImageReturn(nil, toError(err))
+		return newPullImageReturn(nil, nil, toError(err))
 	}
 
 	defer responseBody.Close()
 
-	return processPullResponse(ctx, docker, responseBody, distributionRef, onProgressUpdate, callbackUserData)
+	return processPullResponse(ctx, docker, responseBody, distributionRef, requestedPlatform, bool(allTags), onProgressUpdate, callbackUserData)
 }
 
 func getAuthResolver(clientHandle DockerClientHandle) func(ctx context.Context, index *registrytypes.IndexInfo) registrytypes.AuthConfig {
@@ -118,54 +143,136 @@ func processPullResponse(
 	docker *client.Client,
 	responseBody io.ReadCloser,
 	originalReference reference.Named,
+	requestedPlatform *ocispec.Platform,
+	allTags bool,
 	onProgressUpdate PullImageProgressCallback,
 	callbackUserData unsafe.Pointer,
 ) PullImageReturn {
-	pulledDigest := ""
+	// The daemon reports one digest for each tag it pulls, so there is more than one when pulling all tags.
+	var pulledDigests []digest.Digest
 
 	err := parsePullResponseBody(responseBody, func(message jsonmessage.JSONMessage) error {
 		if strings.HasPrefix(message.Status, "Digest: ") {
-			pulledDigest = strings.TrimPrefix(message.Status, "Digest: ")
+			pulledDigests = append(pulledDigests, digest.Digest(strings.TrimPrefix(message.Status, "Digest: ")))
 		}
 
-		var progressDetail PullImageProgressDetail
-		defer C.FreePullImageProgressDetail(progressDetail)
+		return notifyProgress(message, onProgressUpdate, callbackUserData)
+	})
 
-		if message.Progress != nil {
-			progressDetail = newPullImageProgressDetail(message.Progress.Current, message.Progress.Total)
-		}
+	if err != nil {
+		return newPullImageReturn(nil, nil, toError(err))
+	}
 
-		progressUpdate := newPullImageProgressUpdate(message.Status, progressDetail, message.ID)
-		defer C.FreePullImageProgressUpdate(progressUpdate)
+	if allTags {
+		return findAllPulledImages(ctx, docker, originalReference, pulledDigests, requestedPlatform)
+	}
+
+	lookupReference := originalReference
+
+	if len(pulledDigests) > 0 {
+		lookupReference, err = reference.WithDigest(originalReference, pulledDigests[len(pulledDigests)-1])
 
-		if !invokePullImageProgressCallback(onProgressUpdate, callbackUserData, progressUpdate) {
-			return ErrProgressCallbackFailed
+		if err != nil {
+			return newPullImageReturn(nil, nil, toError(err))
 		}
+	}
 
-		return nil
-	})
+	imageID, err := findPulledImage(ctx, docker, lookupReference, requestedPlatform)
 
 	if err != nil {
-		return newPullImageReturn(nil, toError(err))
+		return newPullImageReturn(nil, nil, toError(err))
 	}
 
-	lookupReference := originalReference
+	return newPullImageReturn(newImageReference(imageID), nil, nil)
+}
+
+func findAllPulledImages(
+	ctx context.Context,
+	docker *client.Client,
+	repository reference.Named,
+	pulledDigests []digest.Digest,
+	requestedPlatform *ocispec.Platform,
+) PullImageReturn {
+	seenImageIDs := map[string]bool{}
+	images := make([]ImageReference, 0, len(pulledDigests))
 
-	if pulledDigest != "" {
-		lookupReference, err = reference.WithDigest(originalReference, digest.Digest(pulledDigest))
+	for _, d := range pulledDigests {
+		lookupReference, err := reference.WithDigest(repository, d)
 
 		if err != nil {
-			return newPullImageReturn(nil, toError(err))
+			return newPullImageReturn(nil, nil, toError(err))
 		}
+
+		imageID, err := findPulledImage(ctx, docker, lookupReference, requestedPlatform)
+
+		if err != nil {
+			return newPullImageReturn(nil, nil, toError(err))
+		}
+
+		if seenImageIDs[imageID] {
+			continue
+		}
+
+		seenImageIDs[imageID] = true
+		images = append(images, newImageReference(imageID))
 	}
 
-	ref, err := getImageReference(ctx, docker, lookupReference.String())
+	return newPullImageReturn(nil, images, nil)
+}
+
+func findPulledImage(ctx context.Context, docker *client.Client, lookupReference reference.Named, requestedPlatform *ocispec.Platform) (string, error) {
+	image, _, err := docker.ImageInspectWithRaw(ctx, lookupReference.String())
 
 	if err != nil {
-		return newPullImageReturn(nil, toError(fmt.Errorf("could not get image reference after pulling image: %w", err)))
+		return "", fmt.Errorf("could not get image reference after pulling image: %w", err)
+	}
+
+	if requestedPlatform != nil {
+		if err := checkImagePlatform(lookupReference, image, *requestedPlatform); err != nil {
+			return "", err
+		}
+	}
+
+	return image.ID, nil
+}
+
+// checkImagePlatform returns an error if the daemon resolved the reference to an image for a different platform to the one requested,
+// which can happen if the image was already present locally or the registry doesn't have a manifest for the requested platform.
+func checkImagePlatform(ref reference.Named, image types.ImageInspect, requested ocispec.Platform) error {
+	actual := ocispec.Platform{
+		OS:           image.Os,
+		Architecture: image.Architecture,
+		Variant:      image.Variant,
+	}
+
+	if platforms.NewMatcher(requested).Match(platforms.Normalize(actual)) {
+		return nil
+	}
+
+	return ImagePlatformMismatchError{
+		Reference:         reference.FamiliarString(ref),
+		RequestedPlatform: platforms.Format(requested),
+		ActualPlatform:    platforms.Format(actual),
+	}
+}
+
+// notifyProgress reports a progress message from a pull or push to the caller.
+func notifyProgress(message jsonmessage.JSONMessage, onProgressUpdate PullImageProgressCallback, callbackUserData unsafe.Pointer) error {
+	var progressDetail PullImageProgressDetail
+	defer C.FreePullImageProgressDetail(progressDetail)
+
+	if message.Progress != nil {
+		progressDetail = newPullImageProgressDetail(message.Progress.Current, message.Progress.Total)
+	}
+
+	progressUpdate := newPullImageProgressUpdate(message.Status, progressDetail, message.ID)
+	defer C.FreePullImageProgressUpdate(progressUpdate)
+
+	if !invokePullImageProgressCallback(onProgressUpdate, callbackUserData, progressUpdate) {
+		return ErrProgressCallbackFailed
 	}
 
-	return newPullImageReturn(ref, nil)
+	return nil
 }
 
 func parsePullResponseBody(body io.ReadCloser, callback func(message jsonmessage.JSONMessage) error) error {
@@ -183,7 +290,7 @@ func parsePullResponseBody(body io.ReadCloser, callback func(message jsonmessage
 		}
 
 		if message.Error != nil {
-			return errors.New(message.Error.Message) //nolint:goerr113
+			return message.Error
 		}
 
 		if message.ErrorMessage != "" {
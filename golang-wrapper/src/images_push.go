@@ -0,0 +1,116 @@
+// Copyright 2017-2022 Charles Korn.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	/*
+		#include "types.h"
+	*/
+	"C"
+	"encoding/json"
+	"io"
+	"unsafe"
+
+	"github.com/docker/cli/cli/trust"
+	"github.com/docker/distribution/reference"
+	"github.com/docker/docker/api/types"
+	imagetypes "github.com/docker/docker/api/types/image"
+	registrytypes "github.com/docker/docker/api/types/registry"
+	"github.com/docker/docker/pkg/jsonmessage"
+)
+
+//export PushImage
+func PushImage(
+	clientHandle DockerClientHandle,
+	contextHandle ContextHandle,
+	ref *C.char,
+	onProgressUpdate PullImageProgressCallback,
+	callbackUserData unsafe.Pointer,
+) PushImageReturn {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	distributionRef, err := reference.ParseNormalizedNamed(C.GoString(ref))
+
+	if err != nil {
+		return newPushImageReturn("", toError(err))
+	}
+
+	imgRefAndAuth, err := trust.GetImageReferencesAndAuth(
+		ctx,
+		getAuthResolver(clientHandle),
+		distributionRef.String(),
+	)
+
+	if err != nil {
+		return newPushImageReturn("", toError(err))
+	}
+
+	encodedAuth, err := registrytypes.EncodeAuthConfig(*imgRefAndAuth.AuthConfig())
+
+	if err != nil {
+		return newPushImageReturn("", toError(err))
+	}
+
+	options := imagetypes.PushOptions{
+		RegistryAuth: encodedAuth,
+		All:          false,
+	}
+
+	cleanedReference := reference.FamiliarString(imgRefAndAuth.Reference())
+	responseBody, err := docker.ImagePush(ctx, cleanedReference, options)
+
+	if err != nil {
+		return newPushImageReturn("", toError(err))
+	}
+
+	defer responseBody.Close()
+
+	return processPushResponse(responseBody, cleanedReference, onProgressUpdate, callbackUserData)
+}
+
+func processPushResponse(
+	responseBody io.ReadCloser,
+	pushedReference string,
+	onProgressUpdate PullImageProgressCallback,
+	callbackUserData unsafe.Pointer,
+) PushImageReturn {
+	pushedDigest := ""
+
+	err := parsePullResponseBody(responseBody, func(message jsonmessage.JSONMessage) error {
+		// The daemon reports the digest of the pushed manifest in an auxiliary message once the push is complete.
+		if message.Aux != nil {
+			var result types.PushResult
+
+			if err := json.Unmarshal(*message.Aux, &result); err == nil && result.Digest != "" {
+				pushedDigest = result.Digest
+			}
+
+			return nil
+		}
+
+		return notifyProgress(message, onProgressUpdate, callbackUserData)
+	})
+
+	if err != nil {
+		return newPushImageReturn("", toError(err))
+	}
+
+	if pushedDigest == "" {
+		return newPushImageReturn("", toError(PushDigestNotReportedError{Reference: pushedReference}))
+	}
+
+	return newPushImageReturn(pushedDigest, nil)
+}
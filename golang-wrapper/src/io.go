@@ -17,6 +17,7 @@ package main
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 )
 
@@ -36,3 +37,26 @@ func (p pipe) Close() error {
 
 	return nil
 }
+
+// writeToFile writes everything from source to the file at path, removing the file if something goes wrong so we don't leave a truncated file behind.
+func writeToFile(path string, source io.Reader) error {
+	f, err := os.Create(path)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(f, source)
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(path)
+
+		return err
+	}
+
+	return nil
+}
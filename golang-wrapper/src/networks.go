@@ -74,3 +74,29 @@ func GetNetworkByNameOrID(clientHandle DockerClientHandle, contextHandle Context
 
 	return newGetNetworkByNameOrIDReturn(response, nil)
 }
+
+//export ConnectContainerToNetwork
+func ConnectContainerToNetwork(clientHandle DockerClientHandle, contextHandle ContextHandle, containerID *C.char, attachment *C.ContainerNetworkAttachment) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	settings := endpointSettingsForNetworkAttachment(attachment)
+
+	if err := docker.NetworkConnect(ctx, C.GoString(attachment.NetworkReference), C.GoString(containerID), settings); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
+
+//export DisconnectContainerFromNetwork
+func DisconnectContainerFromNetwork(clientHandle DockerClientHandle, contextHandle ContextHandle, containerID *C.char, networkReference *C.char, force C.bool) Error {
+	docker := clientHandle.DockerAPIClient()
+	ctx := contextHandle.Context()
+
+	if err := docker.NetworkDisconnect(ctx, C.GoString(networkReference), C.GoString(containerID), bool(force)); err != nil {
+		return toError(err)
+	}
+
+	return nil
+}
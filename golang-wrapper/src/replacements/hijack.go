@@ -16,6 +16,7 @@ package replacements
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"sync"
@@ -23,6 +24,7 @@ import (
 	"github.com/docker/cli/cli/streams"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/moby/term"
 )
 
 // This file is based on github.com/docker/cli/command/container/hijack.go,
@@ -36,8 +38,21 @@ type HijackedIOStreamer struct {
 	Resp types.HijackedResponse
 
 	Tty bool
+
+	// DetachOnEscapeKeys enables watching the input stream for DetachKeys. It only has an effect when Tty is true.
+	// Exec sessions leave this disabled, so their input is passed through untouched.
+	DetachOnEscapeKeys bool
+
+	// DetachKeys is the key sequence (in the same format as the CLI's --detach-keys option) that detaches from the container
+	// without stopping it. The daemon's default is used if it is empty.
+	DetachKeys string
+
+	inputReader io.Reader
 }
 
+// ErrDetached is returned by Stream when the user detaches using the detach key sequence.
+var ErrDetached = errors.New("detached from container")
+
 // FIXME: if the output stream ends first, this method will leak the input streaming goroutine
 // until one last read() operation returns.
 // In the context of this Kotlin library, the stdin stream will be closed by the Kotlin side as soon
@@ -53,11 +68,13 @@ func (h *HijackedIOStreamer) Stream(ctx context.Context) error {
 	defer restoreInput()
 
 	outputDone := h.beginOutputStream(restoreInput)
-	inputDone := h.beginInputStream(restoreInput)
+	inputDone, detached := h.beginInputStream(restoreInput)
 
 	select {
 	case err := <-outputDone:
 		return err
+	case <-detached:
+		return ErrDetached
 	case inputErr := <-inputDone:
 		if h.OutputStream != nil || h.ErrorStream != nil {
 			select {
@@ -77,6 +94,10 @@ func (h *HijackedIOStreamer) Stream(ctx context.Context) error {
 }
 
 func (h *HijackedIOStreamer) setupInput() (restore func(), err error) {
+	if h.InputStream != nil {
+		h.inputReader = h.InputStream
+	}
+
 	if h.InputStream == nil || !h.Tty {
 		return func() {}, nil
 	}
@@ -85,6 +106,18 @@ func (h *HijackedIOStreamer) setupInput() (restore func(), err error) {
 		return nil, fmt.Errorf("unable to set IO streams as raw terminal: %w", err)
 	}
 
+	if h.DetachOnEscapeKeys {
+		escapeKeys, err := DetachKeysToBytes(h.DetachKeys)
+
+		if err != nil {
+			h.restoreTerminal()
+
+			return nil, err
+		}
+
+		h.inputReader = term.NewEscapeProxy(h.InputStream, escapeKeys)
+	}
+
 	var restoreOnce sync.Once
 	restore = func() {
 		restoreOnce.Do(func() {
@@ -119,15 +152,24 @@ func (h *HijackedIOStreamer) beginOutputStream(restoreInput func()) <-chan error
 	return outputDone
 }
 
-func (h *HijackedIOStreamer) beginInputStream(restoreInput func()) <-chan error {
+func (h *HijackedIOStreamer) beginInputStream(restoreInput func()) (<-chan error, <-chan struct{}) {
 	inputDone := make(chan error, 2)
+	detached := make(chan struct{})
 
 	go func() {
-		if h.InputStream != nil {
-			_, err := io.Copy(h.Resp.Conn, h.InputStream)
+		if h.inputReader != nil {
+			_, err := io.Copy(h.Resp.Conn, h.inputReader)
 
 			restoreInput()
 
+			var escapeErr term.EscapeError
+
+			if errors.As(err, &escapeErr) {
+				close(detached)
+
+				return
+			}
+
 			if err != nil {
 				inputDone <- err
 			}
@@ -140,9 +182,27 @@ func (h *HijackedIOStreamer) beginInputStream(restoreInput func()) <-chan error
 		close(inputDone)
 	}()
 
-	return inputDone
+	return inputDone, detached
 }
 
+// DetachKeysToBytes parses a detach key sequence such as "ctrl-p,ctrl-q", returning the default sequence if keys is empty.
+func DetachKeysToBytes(keys string) ([]byte, error) {
+	if keys == "" {
+		keys = defaultDetachKeys
+	}
+
+	escapeKeys, err := term.ToBytes(keys)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid detach keys '%s': %w", keys, err)
+	}
+
+	return escapeKeys, nil
+}
+
+// This matches the default used by the daemon and the CLI.
+const defaultDetachKeys = "ctrl-p,ctrl-q"
+
 func (h *HijackedIOStreamer) setRawTerminal() error {
 	if err := h.InputStream.SetRawTerminal(); err != nil {
 		return err
@@ -371,7 +371,9 @@ void FreeImageReference(ImageReference* value) {
 PullImageReturn* AllocPullImageReturn() {
     PullImageReturn* value = malloc(sizeof(PullImageReturn));
     value->Response = NULL;
+    value->AllTagsImages = NULL;
     value->Error = NULL;
+    value->AllTagsImagesCount = 0;
 
     return value;
 }
@@ -382,6 +384,11 @@ void FreePullImageReturn(PullImageReturn* value) {
     }
 
     FreeImageReference(value->Response);
+    for (uint64_t i = 0; i < value->AllTagsImagesCount; i++) {
+        FreeImageReference(value->AllTagsImages[i]);
+    }
+
+    free(value->AllTagsImages);
     FreeError(value->Error);
     free(value);
 }
@@ -771,6 +778,7 @@ void FreeDeviceMount(DeviceMount* value) {
 ExposedPort* AllocExposedPort() {
     ExposedPort* value = malloc(sizeof(ExposedPort));
     value->Protocol = NULL;
+    value->LocalIP = NULL;
 
     return value;
 }
@@ -781,6 +789,115 @@ void FreeExposedPort(ExposedPort* value) {
     }
 
     free(value->Protocol);
+    free(value->LocalIP);
+    free(value);
+}
+
+Ulimit* AllocUlimit() {
+    Ulimit* value = malloc(sizeof(Ulimit));
+    value->Name = NULL;
+
+    return value;
+}
+
+void FreeUlimit(Ulimit* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->Name);
+    free(value);
+}
+
+ContainerMount* AllocContainerMount() {
+    ContainerMount* value = malloc(sizeof(ContainerMount));
+    value->Type = NULL;
+    value->Source = NULL;
+    value->Target = NULL;
+    value->Consistency = NULL;
+    value->BindPropagation = NULL;
+    value->VolumeDriver = NULL;
+    value->VolumeDriverOptions = NULL;
+    value->VolumeLabels = NULL;
+    value->VolumeSubpath = NULL;
+    value->VolumeDriverOptionsCount = 0;
+    value->VolumeLabelsCount = 0;
+
+    return value;
+}
+
+void FreeContainerMount(ContainerMount* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->Type);
+    free(value->Source);
+    free(value->Target);
+    free(value->Consistency);
+    free(value->BindPropagation);
+    free(value->VolumeDriver);
+    for (uint64_t i = 0; i < value->VolumeDriverOptionsCount; i++) {
+        FreeStringPair(value->VolumeDriverOptions[i]);
+    }
+
+    free(value->VolumeDriverOptions);
+    for (uint64_t i = 0; i < value->VolumeLabelsCount; i++) {
+        FreeStringPair(value->VolumeLabels[i]);
+    }
+
+    free(value->VolumeLabels);
+    free(value->VolumeSubpath);
+    free(value);
+}
+
+RestartPolicy* AllocRestartPolicy() {
+    RestartPolicy* value = malloc(sizeof(RestartPolicy));
+    value->Name = NULL;
+
+    return value;
+}
+
+void FreeRestartPolicy(RestartPolicy* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->Name);
+    free(value);
+}
+
+ContainerNetworkAttachment* AllocContainerNetworkAttachment() {
+    ContainerNetworkAttachment* value = malloc(sizeof(ContainerNetworkAttachment));
+    value->NetworkReference = NULL;
+    value->Aliases = NULL;
+    value->IPv4Address = NULL;
+    value->IPv6Address = NULL;
+    value->Links = NULL;
+    value->AliasesCount = 0;
+    value->LinksCount = 0;
+
+    return value;
+}
+
+void FreeContainerNetworkAttachment(ContainerNetworkAttachment* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->NetworkReference);
+    for (uint64_t i = 0; i < value->AliasesCount; i++) {
+        free(value->Aliases[i]);
+    }
+
+    free(value->Aliases);
+    free(value->IPv4Address);
+    free(value->IPv6Address);
+    for (uint64_t i = 0; i < value->LinksCount; i++) {
+        free(value->Links[i]);
+    }
+
+    free(value->Links);
     free(value);
 }
 
@@ -807,6 +924,23 @@ CreateContainerRequest* AllocCreateContainerRequest() {
     value->LoggingOptions = NULL;
     value->HealthcheckCommand = NULL;
     value->Labels = NULL;
+    value->CPUSetCPUs = NULL;
+    value->CPUSetMemoryNodes = NULL;
+    value->Ulimits = NULL;
+    value->Mounts = NULL;
+    value->SeccompProfile = NULL;
+    value->AppArmorProfile = NULL;
+    value->SELinuxLabels = NULL;
+    value->Sysctls = NULL;
+    value->UsernsMode = NULL;
+    value->GroupAdd = NULL;
+    value->RestartPolicy = NULL;
+    value->StopSignal = NULL;
+    value->Networks = NULL;
+    value->Platform = NULL;
+    value->IPCMode = NULL;
+    value->PIDMode = NULL;
+    value->UTSMode = NULL;
     value->CommandCount = 0;
     value->EntrypointCount = 0;
     value->ExtraHostsCount = 0;
@@ -821,6 +955,12 @@ CreateContainerRequest* AllocCreateContainerRequest() {
     value->LoggingOptionsCount = 0;
     value->HealthcheckCommandCount = 0;
     value->LabelsCount = 0;
+    value->UlimitsCount = 0;
+    value->MountsCount = 0;
+    value->SELinuxLabelsCount = 0;
+    value->SysctlsCount = 0;
+    value->GroupAddCount = 0;
+    value->NetworksCount = 0;
 
     return value;
 }
@@ -907,6 +1047,47 @@ void FreeCreateContainerRequest(CreateContainerRequest* value) {
     }
 
     free(value->Labels);
+    free(value->CPUSetCPUs);
+    free(value->CPUSetMemoryNodes);
+    for (uint64_t i = 0; i < value->UlimitsCount; i++) {
+        FreeUlimit(value->Ulimits[i]);
+    }
+
+    free(value->Ulimits);
+    for (uint64_t i = 0; i < value->MountsCount; i++) {
+        FreeContainerMount(value->Mounts[i]);
+    }
+
+    free(value->Mounts);
+    free(value->SeccompProfile);
+    free(value->AppArmorProfile);
+    for (uint64_t i = 0; i < value->SELinuxLabelsCount; i++) {
+        free(value->SELinuxLabels[i]);
+    }
+
+    free(value->SELinuxLabels);
+    for (uint64_t i = 0; i < value->SysctlsCount; i++) {
+        FreeStringPair(value->Sysctls[i]);
+    }
+
+    free(value->Sysctls);
+    free(value->UsernsMode);
+    for (uint64_t i = 0; i < value->GroupAddCount; i++) {
+        free(value->GroupAdd[i]);
+    }
+
+    free(value->GroupAdd);
+    FreeRestartPolicy(value->RestartPolicy);
+    free(value->StopSignal);
+    for (uint64_t i = 0; i < value->NetworksCount; i++) {
+        FreeContainerNetworkAttachment(value->Networks[i]);
+    }
+
+    free(value->Networks);
+    free(value->Platform);
+    free(value->IPCMode);
+    free(value->PIDMode);
+    free(value->UTSMode);
     free(value);
 }
 
@@ -973,7 +1154,17 @@ ContainerConfig* AllocContainerConfig() {
     ContainerConfig* value = malloc(sizeof(ContainerConfig));
     value->Labels = NULL;
     value->Healthcheck = NULL;
+    value->StopSignal = NULL;
+    value->Image = NULL;
+    value->EnvironmentVariables = NULL;
+    value->Command = NULL;
+    value->Entrypoint = NULL;
+    value->WorkingDirectory = NULL;
+    value->User = NULL;
     value->LabelsCount = 0;
+    value->EnvironmentVariablesCount = 0;
+    value->CommandCount = 0;
+    value->EntrypointCount = 0;
 
     return value;
 }
@@ -989,6 +1180,25 @@ void FreeContainerConfig(ContainerConfig* value) {
 
     free(value->Labels);
     FreeContainerHealthcheckConfig(value->Healthcheck);
+    free(value->StopSignal);
+    free(value->Image);
+    for (uint64_t i = 0; i < value->EnvironmentVariablesCount; i++) {
+        free(value->EnvironmentVariables[i]);
+    }
+
+    free(value->EnvironmentVariables);
+    for (uint64_t i = 0; i < value->CommandCount; i++) {
+        free(value->Command[i]);
+    }
+
+    free(value->Command);
+    for (uint64_t i = 0; i < value->EntrypointCount; i++) {
+        free(value->Entrypoint[i]);
+    }
+
+    free(value->Entrypoint);
+    free(value->WorkingDirectory);
+    free(value->User);
     free(value);
 }
 
@@ -1034,6 +1244,8 @@ void FreeContainerHealthState(ContainerHealthState* value) {
 ContainerState* AllocContainerState() {
     ContainerState* value = malloc(sizeof(ContainerState));
     value->Health = NULL;
+    value->Status = NULL;
+    value->Error = NULL;
 
     return value;
 }
@@ -1044,6 +1256,8 @@ void FreeContainerState(ContainerState* value) {
     }
 
     FreeContainerHealthState(value->Health);
+    free(value->Status);
+    free(value->Error);
     free(value);
 }
 
@@ -1073,6 +1287,7 @@ void FreeContainerLogConfig(ContainerLogConfig* value) {
 ContainerHostConfig* AllocContainerHostConfig() {
     ContainerHostConfig* value = malloc(sizeof(ContainerHostConfig));
     value->LogConfig = NULL;
+    value->RestartPolicy = NULL;
 
     return value;
 }
@@ -1083,6 +1298,118 @@ void FreeContainerHostConfig(ContainerHostConfig* value) {
     }
 
     FreeContainerLogConfig(value->LogConfig);
+    FreeRestartPolicy(value->RestartPolicy);
+    free(value);
+}
+
+PortBinding* AllocPortBinding() {
+    PortBinding* value = malloc(sizeof(PortBinding));
+    value->Protocol = NULL;
+    value->HostIP = NULL;
+
+    return value;
+}
+
+void FreePortBinding(PortBinding* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->Protocol);
+    free(value->HostIP);
+    free(value);
+}
+
+ContainerMountPoint* AllocContainerMountPoint() {
+    ContainerMountPoint* value = malloc(sizeof(ContainerMountPoint));
+    value->Type = NULL;
+    value->Name = NULL;
+    value->Source = NULL;
+    value->Destination = NULL;
+    value->Driver = NULL;
+    value->Mode = NULL;
+    value->Propagation = NULL;
+
+    return value;
+}
+
+void FreeContainerMountPoint(ContainerMountPoint* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->Type);
+    free(value->Name);
+    free(value->Source);
+    free(value->Destination);
+    free(value->Driver);
+    free(value->Mode);
+    free(value->Propagation);
+    free(value);
+}
+
+ContainerNetworkEndpoint* AllocContainerNetworkEndpoint() {
+    ContainerNetworkEndpoint* value = malloc(sizeof(ContainerNetworkEndpoint));
+    value->NetworkName = NULL;
+    value->NetworkID = NULL;
+    value->EndpointID = NULL;
+    value->Aliases = NULL;
+    value->IPAddress = NULL;
+    value->Gateway = NULL;
+    value->GlobalIPv6Address = NULL;
+    value->IPv6Gateway = NULL;
+    value->MacAddress = NULL;
+    value->AliasesCount = 0;
+
+    return value;
+}
+
+void FreeContainerNetworkEndpoint(ContainerNetworkEndpoint* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->NetworkName);
+    free(value->NetworkID);
+    free(value->EndpointID);
+    for (uint64_t i = 0; i < value->AliasesCount; i++) {
+        free(value->Aliases[i]);
+    }
+
+    free(value->Aliases);
+    free(value->IPAddress);
+    free(value->Gateway);
+    free(value->GlobalIPv6Address);
+    free(value->IPv6Gateway);
+    free(value->MacAddress);
+    free(value);
+}
+
+ContainerNetworkSettings* AllocContainerNetworkSettings() {
+    ContainerNetworkSettings* value = malloc(sizeof(ContainerNetworkSettings));
+    value->Ports = NULL;
+    value->Networks = NULL;
+    value->PortsCount = 0;
+    value->NetworksCount = 0;
+
+    return value;
+}
+
+void FreeContainerNetworkSettings(ContainerNetworkSettings* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    for (uint64_t i = 0; i < value->PortsCount; i++) {
+        FreePortBinding(value->Ports[i]);
+    }
+
+    free(value->Ports);
+    for (uint64_t i = 0; i < value->NetworksCount; i++) {
+        FreeContainerNetworkEndpoint(value->Networks[i]);
+    }
+
+    free(value->Networks);
     free(value);
 }
 
@@ -1093,6 +1420,10 @@ ContainerInspectionResult* AllocContainerInspectionResult() {
     value->HostConfig = NULL;
     value->State = NULL;
     value->Config = NULL;
+    value->NetworkSettings = NULL;
+    value->ImageID = NULL;
+    value->Mounts = NULL;
+    value->MountsCount = 0;
 
     return value;
 }
@@ -1107,6 +1438,13 @@ void FreeContainerInspectionResult(ContainerInspectionResult* value) {
     FreeContainerHostConfig(value->HostConfig);
     FreeContainerState(value->State);
     FreeContainerConfig(value->Config);
+    FreeContainerNetworkSettings(value->NetworkSettings);
+    free(value->ImageID);
+    for (uint64_t i = 0; i < value->MountsCount; i++) {
+        FreeContainerMountPoint(value->Mounts[i]);
+    }
+
+    free(value->Mounts);
     free(value);
 }
 
@@ -1383,87 +1721,804 @@ void FreeInspectExecReturn(InspectExecReturn* value) {
     free(value);
 }
 
-VolumeReference** CreateVolumeReferenceArray(uint64_t size) {
-    return malloc(size * sizeof(VolumeReference*));
-}
+ListContainersRequest* AllocListContainersRequest() {
+    ListContainersRequest* value = malloc(sizeof(ListContainersRequest));
+    value->Filters = NULL;
+    value->FiltersCount = 0;
 
-void SetVolumeReferenceArrayElement(VolumeReference** array, uint64_t index, VolumeReference* value) {
-    array[index] = value;
+    return value;
 }
 
-VolumeReference* GetVolumeReferenceArrayElement(VolumeReference** array, uint64_t index) {
-    return array[index];
-}
+void FreeListContainersRequest(ListContainersRequest* value) {
+    if (value == NULL) {
+        return;
+    }
 
-char** CreatestringArray(uint64_t size) {
-    return malloc(size * sizeof(char*));
-}
+    for (uint64_t i = 0; i < value->FiltersCount; i++) {
+        FreeStringToStringListPair(value->Filters[i]);
+    }
 
-void SetstringArrayElement(char** array, uint64_t index, char* value) {
-    array[index] = value;
+    free(value->Filters);
+    free(value);
 }
 
-char* GetstringArrayElement(char** array, uint64_t index) {
-    return array[index];
-}
+ContainerSummary* AllocContainerSummary() {
+    ContainerSummary* value = malloc(sizeof(ContainerSummary));
+    value->ID = NULL;
+    value->Names = NULL;
+    value->Image = NULL;
+    value->Labels = NULL;
+    value->State = NULL;
+    value->Status = NULL;
+    value->Ports = NULL;
+    value->NamesCount = 0;
+    value->LabelsCount = 0;
+    value->PortsCount = 0;
 
-StringPair** CreateStringPairArray(uint64_t size) {
-    return malloc(size * sizeof(StringPair*));
+    return value;
 }
 
-void SetStringPairArrayElement(StringPair** array, uint64_t index, StringPair* value) {
-    array[index] = value;
-}
+void FreeContainerSummary(ContainerSummary* value) {
+    if (value == NULL) {
+        return;
+    }
 
-StringPair* GetStringPairArrayElement(StringPair** array, uint64_t index) {
-    return array[index];
-}
+    free(value->ID);
+    for (uint64_t i = 0; i < value->NamesCount; i++) {
+        free(value->Names[i]);
+    }
 
-FileBuildSecret** CreateFileBuildSecretArray(uint64_t size) {
-    return malloc(size * sizeof(FileBuildSecret*));
-}
+    free(value->Names);
+    free(value->Image);
+    for (uint64_t i = 0; i < value->LabelsCount; i++) {
+        FreeStringPair(value->Labels[i]);
+    }
 
-void SetFileBuildSecretArrayElement(FileBuildSecret** array, uint64_t index, FileBuildSecret* value) {
-    array[index] = value;
-}
+    free(value->Labels);
+    free(value->State);
+    free(value->Status);
+    for (uint64_t i = 0; i < value->PortsCount; i++) {
+        FreePortBinding(value->Ports[i]);
+    }
 
-FileBuildSecret* GetFileBuildSecretArrayElement(FileBuildSecret** array, uint64_t index) {
-    return array[index];
+    free(value->Ports);
+    free(value);
 }
 
-EnvironmentBuildSecret** CreateEnvironmentBuildSecretArray(uint64_t size) {
-    return malloc(size * sizeof(EnvironmentBuildSecret*));
-}
+ListContainersReturn* AllocListContainersReturn() {
+    ListContainersReturn* value = malloc(sizeof(ListContainersReturn));
+    value->Containers = NULL;
+    value->Error = NULL;
+    value->ContainersCount = 0;
 
-void SetEnvironmentBuildSecretArrayElement(EnvironmentBuildSecret** array, uint64_t index, EnvironmentBuildSecret* value) {
-    array[index] = value;
+    return value;
 }
 
-EnvironmentBuildSecret* GetEnvironmentBuildSecretArrayElement(EnvironmentBuildSecret** array, uint64_t index) {
-    return array[index];
-}
+void FreeListContainersReturn(ListContainersReturn* value) {
+    if (value == NULL) {
+        return;
+    }
 
-SSHAgent** CreateSSHAgentArray(uint64_t size) {
-    return malloc(size * sizeof(SSHAgent*));
-}
+    for (uint64_t i = 0; i < value->ContainersCount; i++) {
+        FreeContainerSummary(value->Containers[i]);
+    }
 
-void SetSSHAgentArrayElement(SSHAgent** array, uint64_t index, SSHAgent* value) {
-    array[index] = value;
+    free(value->Containers);
+    FreeError(value->Error);
+    free(value);
 }
 
-SSHAgent* GetSSHAgentArrayElement(SSHAgent** array, uint64_t index) {
-    return array[index];
-}
+StreamContainerLogsRequest* AllocStreamContainerLogsRequest() {
+    StreamContainerLogsRequest* value = malloc(sizeof(StreamContainerLogsRequest));
+    value->ContainerID = NULL;
 
-DeviceMount** CreateDeviceMountArray(uint64_t size) {
-    return malloc(size * sizeof(DeviceMount*));
+    return value;
 }
 
-void SetDeviceMountArrayElement(DeviceMount** array, uint64_t index, DeviceMount* value) {
-    array[index] = value;
-}
+void FreeStreamContainerLogsRequest(StreamContainerLogsRequest* value) {
+    if (value == NULL) {
+        return;
+    }
 
-DeviceMount* GetDeviceMountArrayElement(DeviceMount** array, uint64_t index) {
+    free(value->ContainerID);
+    free(value);
+}
+
+ContainerStatsSample* AllocContainerStatsSample() {
+    ContainerStatsSample* value = malloc(sizeof(ContainerStatsSample));
+
+    return value;
+}
+
+void FreeContainerStatsSample(ContainerStatsSample* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value);
+}
+
+bool InvokeContainerStatsCallback(ContainerStatsCallback method, void* userData, ContainerStatsSample* stats) {
+    return method(userData, stats);
+}
+
+UploadPath* AllocUploadPath() {
+    UploadPath* value = malloc(sizeof(UploadPath));
+    value->LocalPath = NULL;
+    value->ContainerPath = NULL;
+    value->ExcludePatterns = NULL;
+    value->ExcludePatternsCount = 0;
+
+    return value;
+}
+
+void FreeUploadPath(UploadPath* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->LocalPath);
+    free(value->ContainerPath);
+    for (uint64_t i = 0; i < value->ExcludePatternsCount; i++) {
+        free(value->ExcludePatterns[i]);
+    }
+
+    free(value->ExcludePatterns);
+    free(value);
+}
+
+UploadPathsToContainerRequest* AllocUploadPathsToContainerRequest() {
+    UploadPathsToContainerRequest* value = malloc(sizeof(UploadPathsToContainerRequest));
+    value->Paths = NULL;
+    value->PathsCount = 0;
+
+    return value;
+}
+
+void FreeUploadPathsToContainerRequest(UploadPathsToContainerRequest* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    for (uint64_t i = 0; i < value->PathsCount; i++) {
+        FreeUploadPath(value->Paths[i]);
+    }
+
+    free(value->Paths);
+    free(value);
+}
+
+WaitForContainerToBeHealthyReturn* AllocWaitForContainerToBeHealthyReturn() {
+    WaitForContainerToBeHealthyReturn* value = malloc(sizeof(WaitForContainerToBeHealthyReturn));
+    value->Outcome = NULL;
+    value->LastHealthcheckOutput = NULL;
+    value->Error = NULL;
+
+    return value;
+}
+
+void FreeWaitForContainerToBeHealthyReturn(WaitForContainerToBeHealthyReturn* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->Outcome);
+    free(value->LastHealthcheckOutput);
+    FreeError(value->Error);
+    free(value);
+}
+
+UpdateContainerRequest* AllocUpdateContainerRequest() {
+    UpdateContainerRequest* value = malloc(sizeof(UpdateContainerRequest));
+    value->CPUSetCPUs = NULL;
+    value->CPUSetMemoryNodes = NULL;
+    value->RestartPolicy = NULL;
+
+    return value;
+}
+
+void FreeUpdateContainerRequest(UpdateContainerRequest* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->CPUSetCPUs);
+    free(value->CPUSetMemoryNodes);
+    FreeRestartPolicy(value->RestartPolicy);
+    free(value);
+}
+
+CommitContainerRequest* AllocCommitContainerRequest() {
+    CommitContainerRequest* value = malloc(sizeof(CommitContainerRequest));
+    value->ContainerID = NULL;
+    value->Reference = NULL;
+    value->Author = NULL;
+    value->Message = NULL;
+    value->Changes = NULL;
+    value->Labels = NULL;
+    value->ChangesCount = 0;
+    value->LabelsCount = 0;
+
+    return value;
+}
+
+void FreeCommitContainerRequest(CommitContainerRequest* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->ContainerID);
+    free(value->Reference);
+    free(value->Author);
+    free(value->Message);
+    for (uint64_t i = 0; i < value->ChangesCount; i++) {
+        free(value->Changes[i]);
+    }
+
+    free(value->Changes);
+    for (uint64_t i = 0; i < value->LabelsCount; i++) {
+        FreeStringPair(value->Labels[i]);
+    }
+
+    free(value->Labels);
+    free(value);
+}
+
+CommitContainerReturn* AllocCommitContainerReturn() {
+    CommitContainerReturn* value = malloc(sizeof(CommitContainerReturn));
+    value->Response = NULL;
+    value->Error = NULL;
+
+    return value;
+}
+
+void FreeCommitContainerReturn(CommitContainerReturn* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    FreeImageReference(value->Response);
+    FreeError(value->Error);
+    free(value);
+}
+
+ContainerFilesystemChange* AllocContainerFilesystemChange() {
+    ContainerFilesystemChange* value = malloc(sizeof(ContainerFilesystemChange));
+    value->Path = NULL;
+    value->Kind = NULL;
+
+    return value;
+}
+
+void FreeContainerFilesystemChange(ContainerFilesystemChange* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->Path);
+    free(value->Kind);
+    free(value);
+}
+
+ContainerChangesReturn* AllocContainerChangesReturn() {
+    ContainerChangesReturn* value = malloc(sizeof(ContainerChangesReturn));
+    value->Changes = NULL;
+    value->Error = NULL;
+    value->ChangesCount = 0;
+
+    return value;
+}
+
+void FreeContainerChangesReturn(ContainerChangesReturn* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    for (uint64_t i = 0; i < value->ChangesCount; i++) {
+        FreeContainerFilesystemChange(value->Changes[i]);
+    }
+
+    free(value->Changes);
+    FreeError(value->Error);
+    free(value);
+}
+
+ContainerProcess* AllocContainerProcess() {
+    ContainerProcess* value = malloc(sizeof(ContainerProcess));
+    value->Values = NULL;
+    value->ValuesCount = 0;
+
+    return value;
+}
+
+void FreeContainerProcess(ContainerProcess* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    for (uint64_t i = 0; i < value->ValuesCount; i++) {
+        free(value->Values[i]);
+    }
+
+    free(value->Values);
+    free(value);
+}
+
+ContainerTopResult* AllocContainerTopResult() {
+    ContainerTopResult* value = malloc(sizeof(ContainerTopResult));
+    value->Titles = NULL;
+    value->Processes = NULL;
+    value->TitlesCount = 0;
+    value->ProcessesCount = 0;
+
+    return value;
+}
+
+void FreeContainerTopResult(ContainerTopResult* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    for (uint64_t i = 0; i < value->TitlesCount; i++) {
+        free(value->Titles[i]);
+    }
+
+    free(value->Titles);
+    for (uint64_t i = 0; i < value->ProcessesCount; i++) {
+        FreeContainerProcess(value->Processes[i]);
+    }
+
+    free(value->Processes);
+    free(value);
+}
+
+ContainerTopReturn* AllocContainerTopReturn() {
+    ContainerTopReturn* value = malloc(sizeof(ContainerTopReturn));
+    value->Response = NULL;
+    value->Error = NULL;
+
+    return value;
+}
+
+void FreeContainerTopReturn(ContainerTopReturn* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    FreeContainerTopResult(value->Response);
+    FreeError(value->Error);
+    free(value);
+}
+
+PruneContainersRequest* AllocPruneContainersRequest() {
+    PruneContainersRequest* value = malloc(sizeof(PruneContainersRequest));
+    value->Filters = NULL;
+    value->FiltersCount = 0;
+
+    return value;
+}
+
+void FreePruneContainersRequest(PruneContainersRequest* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    for (uint64_t i = 0; i < value->FiltersCount; i++) {
+        FreeStringToStringListPair(value->Filters[i]);
+    }
+
+    free(value->Filters);
+    free(value);
+}
+
+PruneContainersResult* AllocPruneContainersResult() {
+    PruneContainersResult* value = malloc(sizeof(PruneContainersResult));
+    value->ContainerIDs = NULL;
+    value->ContainerIDsCount = 0;
+
+    return value;
+}
+
+void FreePruneContainersResult(PruneContainersResult* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    for (uint64_t i = 0; i < value->ContainerIDsCount; i++) {
+        free(value->ContainerIDs[i]);
+    }
+
+    free(value->ContainerIDs);
+    free(value);
+}
+
+PruneContainersReturn* AllocPruneContainersReturn() {
+    PruneContainersReturn* value = malloc(sizeof(PruneContainersReturn));
+    value->Response = NULL;
+    value->Error = NULL;
+
+    return value;
+}
+
+void FreePruneContainersReturn(PruneContainersReturn* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    FreePruneContainersResult(value->Response);
+    FreeError(value->Error);
+    free(value);
+}
+
+ImageConfig* AllocImageConfig() {
+    ImageConfig* value = malloc(sizeof(ImageConfig));
+    value->EnvironmentVariables = NULL;
+    value->Entrypoint = NULL;
+    value->Command = NULL;
+    value->WorkingDirectory = NULL;
+    value->User = NULL;
+    value->ExposedPorts = NULL;
+    value->Volumes = NULL;
+    value->Labels = NULL;
+    value->Healthcheck = NULL;
+    value->StopSignal = NULL;
+    value->EnvironmentVariablesCount = 0;
+    value->EntrypointCount = 0;
+    value->CommandCount = 0;
+    value->ExposedPortsCount = 0;
+    value->VolumesCount = 0;
+    value->LabelsCount = 0;
+
+    return value;
+}
+
+void FreeImageConfig(ImageConfig* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    for (uint64_t i = 0; i < value->EnvironmentVariablesCount; i++) {
+        free(value->EnvironmentVariables[i]);
+    }
+
+    free(value->EnvironmentVariables);
+    for (uint64_t i = 0; i < value->EntrypointCount; i++) {
+        free(value->Entrypoint[i]);
+    }
+
+    free(value->Entrypoint);
+    for (uint64_t i = 0; i < value->CommandCount; i++) {
+        free(value->Command[i]);
+    }
+
+    free(value->Command);
+    free(value->WorkingDirectory);
+    free(value->User);
+    for (uint64_t i = 0; i < value->ExposedPortsCount; i++) {
+        free(value->ExposedPorts[i]);
+    }
+
+    free(value->ExposedPorts);
+    for (uint64_t i = 0; i < value->VolumesCount; i++) {
+        free(value->Volumes[i]);
+    }
+
+    free(value->Volumes);
+    for (uint64_t i = 0; i < value->LabelsCount; i++) {
+        FreeStringPair(value->Labels[i]);
+    }
+
+    free(value->Labels);
+    FreeContainerHealthcheckConfig(value->Healthcheck);
+    free(value->StopSignal);
+    free(value);
+}
+
+ImageInspectionResult* AllocImageInspectionResult() {
+    ImageInspectionResult* value = malloc(sizeof(ImageInspectionResult));
+    value->ID = NULL;
+    value->RepoTags = NULL;
+    value->RepoDigests = NULL;
+    value->OS = NULL;
+    value->Architecture = NULL;
+    value->Variant = NULL;
+    value->Author = NULL;
+    value->Config = NULL;
+    value->RootFSType = NULL;
+    value->RootFSLayers = NULL;
+    value->RepoTagsCount = 0;
+    value->RepoDigestsCount = 0;
+    value->RootFSLayersCount = 0;
+
+    return value;
+}
+
+void FreeImageInspectionResult(ImageInspectionResult* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->ID);
+    for (uint64_t i = 0; i < value->RepoTagsCount; i++) {
+        free(value->RepoTags[i]);
+    }
+
+    free(value->RepoTags);
+    for (uint64_t i = 0; i < value->RepoDigestsCount; i++) {
+        free(value->RepoDigests[i]);
+    }
+
+    free(value->RepoDigests);
+    free(value->OS);
+    free(value->Architecture);
+    free(value->Variant);
+    free(value->Author);
+    FreeImageConfig(value->Config);
+    free(value->RootFSType);
+    for (uint64_t i = 0; i < value->RootFSLayersCount; i++) {
+        free(value->RootFSLayers[i]);
+    }
+
+    free(value->RootFSLayers);
+    free(value);
+}
+
+InspectImageReturn* AllocInspectImageReturn() {
+    InspectImageReturn* value = malloc(sizeof(InspectImageReturn));
+    value->Response = NULL;
+    value->Error = NULL;
+
+    return value;
+}
+
+void FreeInspectImageReturn(InspectImageReturn* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    FreeImageInspectionResult(value->Response);
+    FreeError(value->Error);
+    free(value);
+}
+
+ListImagesRequest* AllocListImagesRequest() {
+    ListImagesRequest* value = malloc(sizeof(ListImagesRequest));
+    value->Filters = NULL;
+    value->FiltersCount = 0;
+
+    return value;
+}
+
+void FreeListImagesRequest(ListImagesRequest* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    for (uint64_t i = 0; i < value->FiltersCount; i++) {
+        FreeStringToStringListPair(value->Filters[i]);
+    }
+
+    free(value->Filters);
+    free(value);
+}
+
+ImageSummary* AllocImageSummary() {
+    ImageSummary* value = malloc(sizeof(ImageSummary));
+    value->ID = NULL;
+    value->ParentID = NULL;
+    value->RepoTags = NULL;
+    value->RepoDigests = NULL;
+    value->Labels = NULL;
+    value->RepoTagsCount = 0;
+    value->RepoDigestsCount = 0;
+    value->LabelsCount = 0;
+
+    return value;
+}
+
+void FreeImageSummary(ImageSummary* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->ID);
+    free(value->ParentID);
+    for (uint64_t i = 0; i < value->RepoTagsCount; i++) {
+        free(value->RepoTags[i]);
+    }
+
+    free(value->RepoTags);
+    for (uint64_t i = 0; i < value->RepoDigestsCount; i++) {
+        free(value->RepoDigests[i]);
+    }
+
+    free(value->RepoDigests);
+    for (uint64_t i = 0; i < value->LabelsCount; i++) {
+        FreeStringPair(value->Labels[i]);
+    }
+
+    free(value->Labels);
+    free(value);
+}
+
+ListImagesReturn* AllocListImagesReturn() {
+    ListImagesReturn* value = malloc(sizeof(ListImagesReturn));
+    value->Images = NULL;
+    value->Error = NULL;
+    value->ImagesCount = 0;
+
+    return value;
+}
+
+void FreeListImagesReturn(ListImagesReturn* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    for (uint64_t i = 0; i < value->ImagesCount; i++) {
+        FreeImageSummary(value->Images[i]);
+    }
+
+    free(value->Images);
+    FreeError(value->Error);
+    free(value);
+}
+
+PushImageReturn* AllocPushImageReturn() {
+    PushImageReturn* value = malloc(sizeof(PushImageReturn));
+    value->Digest = NULL;
+    value->Error = NULL;
+
+    return value;
+}
+
+void FreePushImageReturn(PushImageReturn* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    free(value->Digest);
+    FreeError(value->Error);
+    free(value);
+}
+
+SaveImagesRequest* AllocSaveImagesRequest() {
+    SaveImagesRequest* value = malloc(sizeof(SaveImagesRequest));
+    value->References = NULL;
+    value->ReferencesCount = 0;
+
+    return value;
+}
+
+void FreeSaveImagesRequest(SaveImagesRequest* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    for (uint64_t i = 0; i < value->ReferencesCount; i++) {
+        free(value->References[i]);
+    }
+
+    free(value->References);
+    free(value);
+}
+
+LoadImagesReturn* AllocLoadImagesReturn() {
+    LoadImagesReturn* value = malloc(sizeof(LoadImagesReturn));
+    value->References = NULL;
+    value->Error = NULL;
+    value->ReferencesCount = 0;
+
+    return value;
+}
+
+void FreeLoadImagesReturn(LoadImagesReturn* value) {
+    if (value == NULL) {
+        return;
+    }
+
+    for (uint64_t i = 0; i < value->ReferencesCount; i++) {
+        free(value->References[i]);
+    }
+
+    free(value->References);
+    FreeError(value->Error);
+    free(value);
+}
+
+VolumeReference** CreateVolumeReferenceArray(uint64_t size) {
+    return malloc(size * sizeof(VolumeReference*));
+}
+
+void SetVolumeReferenceArrayElement(VolumeReference** array, uint64_t index, VolumeReference* value) {
+    array[index] = value;
+}
+
+VolumeReference* GetVolumeReferenceArrayElement(VolumeReference** array, uint64_t index) {
+    return array[index];
+}
+
+ImageReference** CreateImageReferenceArray(uint64_t size) {
+    return malloc(size * sizeof(ImageReference*));
+}
+
+void SetImageReferenceArrayElement(ImageReference** array, uint64_t index, ImageReference* value) {
+    array[index] = value;
+}
+
+ImageReference* GetImageReferenceArrayElement(ImageReference** array, uint64_t index) {
+    return array[index];
+}
+
+char** CreatestringArray(uint64_t size) {
+    return malloc(size * sizeof(char*));
+}
+
+void SetstringArrayElement(char** array, uint64_t index, char* value) {
+    array[index] = value;
+}
+
+char* GetstringArrayElement(char** array, uint64_t index) {
+    return array[index];
+}
+
+StringPair** CreateStringPairArray(uint64_t size) {
+    return malloc(size * sizeof(StringPair*));
+}
+
+void SetStringPairArrayElement(StringPair** array, uint64_t index, StringPair* value) {
+    array[index] = value;
+}
+
+StringPair* GetStringPairArrayElement(StringPair** array, uint64_t index) {
+    return array[index];
+}
+
+FileBuildSecret** CreateFileBuildSecretArray(uint64_t size) {
+    return malloc(size * sizeof(FileBuildSecret*));
+}
+
+void SetFileBuildSecretArrayElement(FileBuildSecret** array, uint64_t index, FileBuildSecret* value) {
+    array[index] = value;
+}
+
+FileBuildSecret* GetFileBuildSecretArrayElement(FileBuildSecret** array, uint64_t index) {
+    return array[index];
+}
+
+EnvironmentBuildSecret** CreateEnvironmentBuildSecretArray(uint64_t size) {
+    return malloc(size * sizeof(EnvironmentBuildSecret*));
+}
+
+void SetEnvironmentBuildSecretArrayElement(EnvironmentBuildSecret** array, uint64_t index, EnvironmentBuildSecret* value) {
+    array[index] = value;
+}
+
+EnvironmentBuildSecret* GetEnvironmentBuildSecretArrayElement(EnvironmentBuildSecret** array, uint64_t index) {
+    return array[index];
+}
+
+SSHAgent** CreateSSHAgentArray(uint64_t size) {
+    return malloc(size * sizeof(SSHAgent*));
+}
+
+void SetSSHAgentArrayElement(SSHAgent** array, uint64_t index, SSHAgent* value) {
+    array[index] = value;
+}
+
+SSHAgent* GetSSHAgentArrayElement(SSHAgent** array, uint64_t index) {
+    return array[index];
+}
+
+DeviceMount** CreateDeviceMountArray(uint64_t size) {
+    return malloc(size * sizeof(DeviceMount*));
+}
+
+void SetDeviceMountArrayElement(DeviceMount** array, uint64_t index, DeviceMount* value) {
+    array[index] = value;
+}
+
+DeviceMount* GetDeviceMountArrayElement(DeviceMount** array, uint64_t index) {
     return array[index];
 }
 
@@ -1479,6 +2534,42 @@ ExposedPort* GetExposedPortArrayElement(ExposedPort** array, uint64_t index) {
     return array[index];
 }
 
+Ulimit** CreateUlimitArray(uint64_t size) {
+    return malloc(size * sizeof(Ulimit*));
+}
+
+void SetUlimitArrayElement(Ulimit** array, uint64_t index, Ulimit* value) {
+    array[index] = value;
+}
+
+Ulimit* GetUlimitArrayElement(Ulimit** array, uint64_t index) {
+    return array[index];
+}
+
+ContainerMount** CreateContainerMountArray(uint64_t size) {
+    return malloc(size * sizeof(ContainerMount*));
+}
+
+void SetContainerMountArrayElement(ContainerMount** array, uint64_t index, ContainerMount* value) {
+    array[index] = value;
+}
+
+ContainerMount* GetContainerMountArrayElement(ContainerMount** array, uint64_t index) {
+    return array[index];
+}
+
+ContainerNetworkAttachment** CreateContainerNetworkAttachmentArray(uint64_t size) {
+    return malloc(size * sizeof(ContainerNetworkAttachment*));
+}
+
+void SetContainerNetworkAttachmentArrayElement(ContainerNetworkAttachment** array, uint64_t index, ContainerNetworkAttachment* value) {
+    array[index] = value;
+}
+
+ContainerNetworkAttachment* GetContainerNetworkAttachmentArrayElement(ContainerNetworkAttachment** array, uint64_t index) {
+    return array[index];
+}
+
 ContainerHealthLogEntry** CreateContainerHealthLogEntryArray(uint64_t size) {
     return malloc(size * sizeof(ContainerHealthLogEntry*));
 }
@@ -1491,6 +2582,42 @@ ContainerHealthLogEntry* GetContainerHealthLogEntryArrayElement(ContainerHealthL
     return array[index];
 }
 
+PortBinding** CreatePortBindingArray(uint64_t size) {
+    return malloc(size * sizeof(PortBinding*));
+}
+
+void SetPortBindingArrayElement(PortBinding** array, uint64_t index, PortBinding* value) {
+    array[index] = value;
+}
+
+PortBinding* GetPortBindingArrayElement(PortBinding** array, uint64_t index) {
+    return array[index];
+}
+
+ContainerNetworkEndpoint** CreateContainerNetworkEndpointArray(uint64_t size) {
+    return malloc(size * sizeof(ContainerNetworkEndpoint*));
+}
+
+void SetContainerNetworkEndpointArrayElement(ContainerNetworkEndpoint** array, uint64_t index, ContainerNetworkEndpoint* value) {
+    array[index] = value;
+}
+
+ContainerNetworkEndpoint* GetContainerNetworkEndpointArrayElement(ContainerNetworkEndpoint** array, uint64_t index) {
+    return array[index];
+}
+
+ContainerMountPoint** CreateContainerMountPointArray(uint64_t size) {
+    return malloc(size * sizeof(ContainerMountPoint*));
+}
+
+void SetContainerMountPointArrayElement(ContainerMountPoint** array, uint64_t index, ContainerMountPoint* value) {
+    array[index] = value;
+}
+
+ContainerMountPoint* GetContainerMountPointArrayElement(ContainerMountPoint** array, uint64_t index) {
+    return array[index];
+}
+
 UploadDirectory** CreateUploadDirectoryArray(uint64_t size) {
     return malloc(size * sizeof(UploadDirectory*));
 }
@@ -1526,3 +2653,63 @@ void SetStringToStringListPairArrayElement(StringToStringListPair** array, uint6
 StringToStringListPair* GetStringToStringListPairArrayElement(StringToStringListPair** array, uint64_t index) {
     return array[index];
 }
+
+ContainerSummary** CreateContainerSummaryArray(uint64_t size) {
+    return malloc(size * sizeof(ContainerSummary*));
+}
+
+void SetContainerSummaryArrayElement(ContainerSummary** array, uint64_t index, ContainerSummary* value) {
+    array[index] = value;
+}
+
+ContainerSummary* GetContainerSummaryArrayElement(ContainerSummary** array, uint64_t index) {
+    return array[index];
+}
+
+UploadPath** CreateUploadPathArray(uint64_t size) {
+    return malloc(size * sizeof(UploadPath*));
+}
+
+void SetUploadPathArrayElement(UploadPath** array, uint64_t index, UploadPath* value) {
+    array[index] = value;
+}
+
+UploadPath* GetUploadPathArrayElement(UploadPath** array, uint64_t index) {
+    return array[index];
+}
+
+ContainerFilesystemChange** CreateContainerFilesystemChangeArray(uint64_t size) {
+    return malloc(size * sizeof(ContainerFilesystemChange*));
+}
+
+void SetContainerFilesystemChangeArrayElement(ContainerFilesystemChange** array, uint64_t index, ContainerFilesystemChange* value) {
+    array[index] = value;
+}
+
+ContainerFilesystemChange* GetContainerFilesystemChangeArrayElement(ContainerFilesystemChange** array, uint64_t index) {
+    return array[index];
+}
+
+ContainerProcess** CreateContainerProcessArray(uint64_t size) {
+    return malloc(size * sizeof(ContainerProcess*));
+}
+
+void SetContainerProcessArrayElement(ContainerProcess** array, uint64_t index, ContainerProcess* value) {
+    array[index] = value;
+}
+
+ContainerProcess* GetContainerProcessArrayElement(ContainerProcess** array, uint64_t index) {
+    return array[index];
+}
+
+ImageSummary** CreateImageSummaryArray(uint64_t size) {
+    return malloc(size * sizeof(ImageSummary*));
+}
+
+void SetImageSummaryArrayElement(ImageSummary** array, uint64_t index, ImageSummary* value) {
+    array[index] = value;
+}
+
+ImageSummary* GetImageSummaryArrayElement(ImageSummary** array, uint64_t index) {
+    return array[index];
+}
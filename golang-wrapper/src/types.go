@@ -72,6 +72,10 @@ type BuildImageProgressCallback C.BuildImageProgressCallback
 type ContainerReference *C.ContainerReference
 type DeviceMount *C.DeviceMount
 type ExposedPort *C.ExposedPort
+type Ulimit *C.Ulimit
+type ContainerMount *C.ContainerMount
+type RestartPolicy *C.RestartPolicy
+type ContainerNetworkAttachment *C.ContainerNetworkAttachment
 type CreateContainerRequest *C.CreateContainerRequest
 type CreateContainerReturn *C.CreateContainerReturn
 type WaitForContainerToExitReturn *C.WaitForContainerToExitReturn
@@ -83,6 +87,10 @@ type ContainerHealthState *C.ContainerHealthState
 type ContainerState *C.ContainerState
 type ContainerLogConfig *C.ContainerLogConfig
 type ContainerHostConfig *C.ContainerHostConfig
+type PortBinding *C.PortBinding
+type ContainerMountPoint *C.ContainerMountPoint
+type ContainerNetworkEndpoint *C.ContainerNetworkEndpoint
+type ContainerNetworkSettings *C.ContainerNetworkSettings
 type ContainerInspectionResult *C.ContainerInspectionResult
 type InspectContainerReturn *C.InspectContainerReturn
 type UploadDirectory *C.UploadDirectory
@@ -98,6 +106,35 @@ type ContainerExecReference *C.ContainerExecReference
 type CreateExecReturn *C.CreateExecReturn
 type InspectExecResult *C.InspectExecResult
 type InspectExecReturn *C.InspectExecReturn
+type ListContainersRequest *C.ListContainersRequest
+type ContainerSummary *C.ContainerSummary
+type ListContainersReturn *C.ListContainersReturn
+type StreamContainerLogsRequest *C.StreamContainerLogsRequest
+type ContainerStatsSample *C.ContainerStatsSample
+type ContainerStatsCallback C.ContainerStatsCallback
+type UploadPath *C.UploadPath
+type UploadPathsToContainerRequest *C.UploadPathsToContainerRequest
+type WaitForContainerToBeHealthyReturn *C.WaitForContainerToBeHealthyReturn
+type UpdateContainerRequest *C.UpdateContainerRequest
+type CommitContainerRequest *C.CommitContainerRequest
+type CommitContainerReturn *C.CommitContainerReturn
+type ContainerFilesystemChange *C.ContainerFilesystemChange
+type ContainerChangesReturn *C.ContainerChangesReturn
+type ContainerProcess *C.ContainerProcess
+type ContainerTopResult *C.ContainerTopResult
+type ContainerTopReturn *C.ContainerTopReturn
+type PruneContainersRequest *C.PruneContainersRequest
+type PruneContainersResult *C.PruneContainersResult
+type PruneContainersReturn *C.PruneContainersReturn
+type ImageConfig *C.ImageConfig
+type ImageInspectionResult *C.ImageInspectionResult
+type InspectImageReturn *C.InspectImageReturn
+type ListImagesRequest *C.ListImagesRequest
+type ImageSummary *C.ImageSummary
+type ListImagesReturn *C.ListImagesReturn
+type PushImageReturn *C.PushImageReturn
+type SaveImagesRequest *C.SaveImagesRequest
+type LoadImagesReturn *C.LoadImagesReturn
 
 func newError(
     Type string,
@@ -341,10 +378,19 @@ func newImageReference(
 
 func newPullImageReturn(
     Response ImageReference,
+    AllTagsImages []ImageReference,
     Error Error,
 ) PullImageReturn {
     value := C.AllocPullImageReturn()
     value.Response = Response
+
+    value.AllTagsImagesCount = C.uint64_t(len(AllTagsImages))
+    value.AllTagsImages = C.CreateImageReferenceArray(value.AllTagsImagesCount)
+
+    for i, v := range AllTagsImages {
+        C.SetImageReferenceArrayElement(value.AllTagsImages, C.uint64_t(i), v)
+    }
+
     value.Error = Error
 
     return value
@@ -633,11 +679,119 @@ func newExposedPort(
     LocalPort int64,
     ContainerPort int64,
     Protocol string,
+    LocalIP string,
+    LocalPortRangeEnd int64,
+    ContainerPortRangeEnd int64,
 ) ExposedPort {
     value := C.AllocExposedPort()
     value.LocalPort = C.int64_t(LocalPort)
     value.ContainerPort = C.int64_t(ContainerPort)
     value.Protocol = C.CString(Protocol)
+    value.LocalIP = C.CString(LocalIP)
+    value.LocalPortRangeEnd = C.int64_t(LocalPortRangeEnd)
+    value.ContainerPortRangeEnd = C.int64_t(ContainerPortRangeEnd)
+
+    return value
+}
+
+func newUlimit(
+    Name string,
+    Soft int64,
+    Hard int64,
+) Ulimit {
+    value := C.AllocUlimit()
+    value.Name = C.CString(Name)
+    value.Soft = C.int64_t(Soft)
+    value.Hard = C.int64_t(Hard)
+
+    return value
+}
+
+func newContainerMount(
+    Type string,
+    Source string,
+    Target string,
+    ReadOnly bool,
+    Consistency string,
+    BindPropagation string,
+    VolumeDriver string,
+    VolumeDriverOptions []StringPair,
+    VolumeLabels []StringPair,
+    VolumeNoCopy bool,
+    VolumeSubpath string,
+    TmpfsSizeInBytes int64,
+    TmpfsMode int32,
+) ContainerMount {
+    value := C.AllocContainerMount()
+    value.Type = C.CString(Type)
+    value.Source = C.CString(Source)
+    value.Target = C.CString(Target)
+    value.ReadOnly = C.bool(ReadOnly)
+    value.Consistency = C.CString(Consistency)
+    value.BindPropagation = C.CString(BindPropagation)
+    value.VolumeDriver = C.CString(VolumeDriver)
+
+    value.VolumeDriverOptionsCount = C.uint64_t(len(VolumeDriverOptions))
+    value.VolumeDriverOptions = C.CreateStringPairArray(value.VolumeDriverOptionsCount)
+
+    for i, v := range VolumeDriverOptions {
+        C.SetStringPairArrayElement(value.VolumeDriverOptions, C.uint64_t(i), v)
+    }
+
+
+    value.VolumeLabelsCount = C.uint64_t(len(VolumeLabels))
+    value.VolumeLabels = C.CreateStringPairArray(value.VolumeLabelsCount)
+
+    for i, v := range VolumeLabels {
+        C.SetStringPairArrayElement(value.VolumeLabels, C.uint64_t(i), v)
+    }
+
+    value.VolumeNoCopy = C.bool(VolumeNoCopy)
+    value.VolumeSubpath = C.CString(VolumeSubpath)
+    value.TmpfsSizeInBytes = C.int64_t(TmpfsSizeInBytes)
+    value.TmpfsMode = C.int32_t(TmpfsMode)
+
+    return value
+}
+
+func newRestartPolicy(
+    Name string,
+    MaximumRetryCount int64,
+) RestartPolicy {
+    value := C.AllocRestartPolicy()
+    value.Name = C.CString(Name)
+    value.MaximumRetryCount = C.int64_t(MaximumRetryCount)
+
+    return value
+}
+
+func newContainerNetworkAttachment(
+    NetworkReference string,
+    Aliases []string,
+    IPv4Address string,
+    IPv6Address string,
+    Links []string,
+) ContainerNetworkAttachment {
+    value := C.AllocContainerNetworkAttachment()
+    value.NetworkReference = C.CString(NetworkReference)
+
+    value.AliasesCount = C.uint64_t(len(Aliases))
+    value.Aliases = C.CreatestringArray(value.AliasesCount)
+
+    for i, v := range Aliases {
+        C.SetstringArrayElement(value.Aliases, C.uint64_t(i), C.CString(v))
+    }
+
+    value.IPv4Address = C.CString(IPv4Address)
+    value.IPv6Address = C.CString(IPv6Address)
+
+    value.LinksCount = C.uint64_t(len(Links))
+    value.Links = C.CreatestringArray(value.LinksCount)
+
+    for i, v := range Links {
+        C.SetstringArrayElement(value.Links, C.uint64_t(i), C.CString(v))
+    }
+
 
     return value
 }
@@ -675,6 +829,40 @@ func newCreateContainerRequest(
     AttachStdin bool,
     StdinOnce bool,
     OpenStdin bool,
+    MemoryLimitInBytes int64,
+    MemoryReservationInBytes int64,
+    MemorySwapLimitInBytes int64,
+    CPUCount float64,
+    CPUQuota int64,
+    CPUPeriod int64,
+    CPUShares int64,
+    CPUSetCPUs string,
+    CPUSetMemoryNodes string,
+    PidsLimit int64,
+    Ulimits []Ulimit,
+    BlockIOWeight int64,
+    Mounts []ContainerMount,
+    ReadOnlyRootFilesystem bool,
+    NoNewPrivileges bool,
+    SeccompProfile string,
+    AppArmorProfile string,
+    SELinuxLabels []string,
+    Sysctls []StringPair,
+    UsernsMode string,
+    GroupAdd []string,
+    OOMScoreAdjustment int64,
+    RestartPolicy RestartPolicy,
+    StopSignal string,
+    HaveStopTimeout bool,
+    StopTimeoutSeconds int64,
+    Networks []ContainerNetworkAttachment,
+    AutoRemove bool,
+    Platform string,
+    IPCMode string,
+    PIDMode string,
+    UTSMode string,
+    InitialConsoleHeight int64,
+    InitialConsoleWidth int64,
 ) CreateContainerRequest {
     value := C.AllocCreateContainerRequest()
     value.ImageReference = C.CString(ImageReference)
@@ -807,6 +995,82 @@ func newCreateContainerRequest(
     value.AttachStdin = C.bool(AttachStdin)
     value.StdinOnce = C.bool(StdinOnce)
     value.OpenStdin = C.bool(OpenStdin)
+    value.MemoryLimitInBytes = C.int64_t(MemoryLimitInBytes)
+    value.MemoryReservationInBytes = C.int64_t(MemoryReservationInBytes)
+    value.MemorySwapLimitInBytes = C.int64_t(MemorySwapLimitInBytes)
+    value.CPUCount = C.double(CPUCount)
+    value.CPUQuota = C.int64_t(CPUQuota)
+    value.CPUPeriod = C.int64_t(CPUPeriod)
+    value.CPUShares = C.int64_t(CPUShares)
+    value.CPUSetCPUs = C.CString(CPUSetCPUs)
+    value.CPUSetMemoryNodes = C.CString(CPUSetMemoryNodes)
+    value.PidsLimit = C.int64_t(PidsLimit)
+
+    value.UlimitsCount = C.uint64_t(len(Ulimits))
+    value.Ulimits = C.CreateUlimitArray(value.UlimitsCount)
+
+    for i, v := range Ulimits {
+        C.SetUlimitArrayElement(value.Ulimits, C.uint64_t(i), v)
+    }
+
+    value.BlockIOWeight = C.int64_t(BlockIOWeight)
+
+    value.MountsCount = C.uint64_t(len(Mounts))
+    value.Mounts = C.CreateContainerMountArray(value.MountsCount)
+
+    for i, v := range Mounts {
+        C.SetContainerMountArrayElement(value.Mounts, C.uint64_t(i), v)
+    }
+
+    value.ReadOnlyRootFilesystem = C.bool(ReadOnlyRootFilesystem)
+    value.NoNewPrivileges = C.bool(NoNewPrivileges)
+    value.SeccompProfile = C.CString(SeccompProfile)
+    value.AppArmorProfile = C.CString(AppArmorProfile)
+
+    value.SELinuxLabelsCount = C.uint64_t(len(SELinuxLabels))
+    value.SELinuxLabels = C.CreatestringArray(value.SELinuxLabelsCount)
+
+    for i, v := range SELinuxLabels {
+        C.SetstringArrayElement(value.SELinuxLabels, C.uint64_t(i), C.CString(v))
+    }
+
+
+    value.SysctlsCount = C.uint64_t(len(Sysctls))
+    value.Sysctls = C.CreateStringPairArray(value.SysctlsCount)
+
+    for i, v := range Sysctls {
+        C.SetStringPairArrayElement(value.Sysctls, C.uint64_t(i), v)
+    }
+
+    value.UsernsMode = C.CString(UsernsMode)
+
+    value.GroupAddCount = C.uint64_t(len(GroupAdd))
+    value.GroupAdd = C.CreatestringArray(value.GroupAddCount)
+
+    for i, v := range GroupAdd {
+        C.SetstringArrayElement(value.GroupAdd, C.uint64_t(i), C.CString(v))
+    }
+
+    value.OOMScoreAdjustment = C.int64_t(OOMScoreAdjustment)
+    value.RestartPolicy = RestartPolicy
+    value.StopSignal = C.CString(StopSignal)
+    value.HaveStopTimeout = C.bool(HaveStopTimeout)
+    value.StopTimeoutSeconds = C.int64_t(StopTimeoutSeconds)
+
+    value.NetworksCount = C.uint64_t(len(Networks))
+    value.Networks = C.CreateContainerNetworkAttachmentArray(value.NetworksCount)
+
+    for i, v := range Networks {
+        C.SetContainerNetworkAttachmentArrayElement(value.Networks, C.uint64_t(i), v)
+    }
+
+    value.AutoRemove = C.bool(AutoRemove)
+    value.Platform = C.CString(Platform)
+    value.IPCMode = C.CString(IPCMode)
+    value.PIDMode = C.CString(PIDMode)
+    value.UTSMode = C.CString(UTSMode)
+    value.InitialConsoleHeight = C.int64_t(InitialConsoleHeight)
+    value.InitialConsoleWidth = C.int64_t(InitialConsoleWidth)
 
     return value
 }
@@ -860,6 +1124,15 @@ func newContainerHealthcheckConfig(
 func newContainerConfig(
     Labels []StringPair,
     Healthcheck ContainerHealthcheckConfig,
+    StopSignal string,
+    HaveStopTimeout bool,
+    StopTimeoutSeconds int64,
+    Image string,
+    EnvironmentVariables []string,
+    Command []string,
+    Entrypoint []string,
+    WorkingDirectory string,
+    User string,
 ) ContainerConfig {
     value := C.AllocContainerConfig()
 
@@ -871,6 +1144,36 @@ func newContainerConfig(
     }
 
     value.Healthcheck = Healthcheck
+    value.StopSignal = C.CString(StopSignal)
+    value.HaveStopTimeout = C.bool(HaveStopTimeout)
+    value.StopTimeoutSeconds = C.int64_t(StopTimeoutSeconds)
+    value.Image = C.CString(Image)
+
+    value.EnvironmentVariablesCount = C.uint64_t(len(EnvironmentVariables))
+    value.EnvironmentVariables = C.CreatestringArray(value.EnvironmentVariablesCount)
+
+    for i, v := range EnvironmentVariables {
+        C.SetstringArrayElement(value.EnvironmentVariables, C.uint64_t(i), C.CString(v))
+    }
+
+
+    value.CommandCount = C.uint64_t(len(Command))
+    value.Command = C.CreatestringArray(value.CommandCount)
+
+    for i, v := range Command {
+        C.SetstringArrayElement(value.Command, C.uint64_t(i), C.CString(v))
+    }
+
+
+    value.EntrypointCount = C.uint64_t(len(Entrypoint))
+    value.Entrypoint = C.CreatestringArray(value.EntrypointCount)
+
+    for i, v := range Entrypoint {
+        C.SetstringArrayElement(value.Entrypoint, C.uint64_t(i), C.CString(v))
+    }
+
+    value.WorkingDirectory = C.CString(WorkingDirectory)
+    value.User = C.CString(User)
 
     return value
 }
@@ -910,9 +1213,31 @@ func newContainerHealthState(
 
 func newContainerState(
     Health ContainerHealthState,
+    Status string,
+    Running bool,
+    Paused bool,
+    Restarting bool,
+    OOMKilled bool,
+    Dead bool,
+    PID int64,
+    ExitCode int64,
+    Error string,
+    StartedAt int64,
+    FinishedAt int64,
 ) ContainerState {
     value := C.AllocContainerState()
     value.Health = Health
+    value.Status = C.CString(Status)
+    value.Running = C.bool(Running)
+    value.Paused = C.bool(Paused)
+    value.Restarting = C.bool(Restarting)
+    value.OOMKilled = C.bool(OOMKilled)
+    value.Dead = C.bool(Dead)
+    value.PID = C.int64_t(PID)
+    value.ExitCode = C.int64_t(ExitCode)
+    value.Error = C.CString(Error)
+    value.StartedAt = C.int64_t(StartedAt)
+    value.FinishedAt = C.int64_t(FinishedAt)
 
     return value
 }
@@ -937,9 +1262,110 @@ func newContainerLogConfig(
 
 func newContainerHostConfig(
     LogConfig ContainerLogConfig,
+    RestartPolicy RestartPolicy,
 ) ContainerHostConfig {
     value := C.AllocContainerHostConfig()
     value.LogConfig = LogConfig
+    value.RestartPolicy = RestartPolicy
+
+    return value
+}
+
+func newPortBinding(
+    ContainerPort int64,
+    Protocol string,
+    HostIP string,
+    HostPort int64,
+) PortBinding {
+    value := C.AllocPortBinding()
+    value.ContainerPort = C.int64_t(ContainerPort)
+    value.Protocol = C.CString(Protocol)
+    value.HostIP = C.CString(HostIP)
+    value.HostPort = C.int64_t(HostPort)
+
+    return value
+}
+
+func newContainerMountPoint(
+    Type string,
+    Name string,
+    Source string,
+    Destination string,
+    Driver string,
+    Mode string,
+    ReadWrite bool,
+    Propagation string,
+) ContainerMountPoint {
+    value := C.AllocContainerMountPoint()
+    value.Type = C.CString(Type)
+    value.Name = C.CString(Name)
+    value.Source = C.CString(Source)
+    value.Destination = C.CString(Destination)
+    value.Driver = C.CString(Driver)
+    value.Mode = C.CString(Mode)
+    value.ReadWrite = C.bool(ReadWrite)
+    value.Propagation = C.CString(Propagation)
+
+    return value
+}
+
+func newContainerNetworkEndpoint(
+    NetworkName string,
+    NetworkID string,
+    EndpointID string,
+    Aliases []string,
+    IPAddress string,
+    IPPrefixLength int64,
+    Gateway string,
+    GlobalIPv6Address string,
+    GlobalIPv6PrefixLength int64,
+    IPv6Gateway string,
+    MacAddress string,
+) ContainerNetworkEndpoint {
+    value := C.AllocContainerNetworkEndpoint()
+    value.NetworkName = C.CString(NetworkName)
+    value.NetworkID = C.CString(NetworkID)
+    value.EndpointID = C.CString(EndpointID)
+
+    value.AliasesCount = C.uint64_t(len(Aliases))
+    value.Aliases = C.CreatestringArray(value.AliasesCount)
+
+    for i, v := range Aliases {
+        C.SetstringArrayElement(value.Aliases, C.uint64_t(i), C.CString(v))
+    }
+
+    value.IPAddress = C.CString(IPAddress)
+    value.IPPrefixLength = C.int64_t(IPPrefixLength)
+    value.Gateway = C.CString(Gateway)
+    value.GlobalIPv6Address = C.CString(GlobalIPv6Address)
+    value.GlobalIPv6PrefixLength = C.int64_t(GlobalIPv6PrefixLength)
+    value.IPv6Gateway = C.CString(IPv6Gateway)
+    value.MacAddress = C.CString(MacAddress)
+
+    return value
+}
+
+func newContainerNetworkSettings(
+    Ports []PortBinding,
+    Networks []ContainerNetworkEndpoint,
+) ContainerNetworkSettings {
+    value := C.AllocContainerNetworkSettings()
+
+    value.PortsCount = C.uint64_t(len(Ports))
+    value.Ports = C.CreatePortBindingArray(value.PortsCount)
+
+    for i, v := range Ports {
+        C.SetPortBindingArrayElement(value.Ports, C.uint64_t(i), v)
+    }
+
+
+    value.NetworksCount = C.uint64_t(len(Networks))
+    value.Networks = C.CreateContainerNetworkEndpointArray(value.NetworksCount)
+
+    for i, v := range Networks {
+        C.SetContainerNetworkEndpointArrayElement(value.Networks, C.uint64_t(i), v)
+    }
+
 
     return value
 }
@@ -950,6 +1376,11 @@ func newContainerInspectionResult(
     HostConfig ContainerHostConfig,
     State ContainerState,
     Config ContainerConfig,
+    NetworkSettings ContainerNetworkSettings,
+    Created int64,
+    ImageID string,
+    RestartCount int64,
+    Mounts []ContainerMountPoint,
 ) ContainerInspectionResult {
     value := C.AllocContainerInspectionResult()
     value.ID = C.CString(ID)
@@ -957,6 +1388,18 @@ func newContainerInspectionResult(
     value.HostConfig = HostConfig
     value.State = State
     value.Config = Config
+    value.NetworkSettings = NetworkSettings
+    value.Created = C.int64_t(Created)
+    value.ImageID = C.CString(ImageID)
+    value.RestartCount = C.int64_t(RestartCount)
+
+    value.MountsCount = C.uint64_t(len(Mounts))
+    value.Mounts = C.CreateContainerMountPointArray(value.MountsCount)
+
+    for i, v := range Mounts {
+        C.SetContainerMountPointArrayElement(value.Mounts, C.uint64_t(i), v)
+    }
+
 
     return value
 }
@@ -1123,6 +1566,8 @@ func newCreateExecRequest(
     WorkingDirectory string,
     User string,
     Privileged bool,
+    InitialConsoleHeight int64,
+    InitialConsoleWidth int64,
 ) CreateExecRequest {
     value := C.AllocCreateExecRequest()
     value.ContainerID = C.CString(ContainerID)
@@ -1149,6 +1594,8 @@ func newCreateExecRequest(
     value.WorkingDirectory = C.CString(WorkingDirectory)
     value.User = C.CString(User)
     value.Privileged = C.bool(Privileged)
+    value.InitialConsoleHeight = C.int64_t(InitialConsoleHeight)
+    value.InitialConsoleWidth = C.int64_t(InitialConsoleWidth)
 
     return value
 }
@@ -1195,6 +1642,669 @@ func newInspectExecReturn(
     return value
 }
 
+func newListContainersRequest(
+    All bool,
+    Filters []StringToStringListPair,
+) ListContainersRequest {
+    value := C.AllocListContainersRequest()
+    value.All = C.bool(All)
+
+    value.FiltersCount = C.uint64_t(len(Filters))
+    value.Filters = C.CreateStringToStringListPairArray(value.FiltersCount)
+
+    for i, v := range Filters {
+        C.SetStringToStringListPairArrayElement(value.Filters, C.uint64_t(i), v)
+    }
+
+
+    return value
+}
+
+func newContainerSummary(
+    ID string,
+    Names []string,
+    Image string,
+    Labels []StringPair,
+    State string,
+    Status string,
+    Ports []PortBinding,
+) ContainerSummary {
+    value := C.AllocContainerSummary()
+    value.ID = C.CString(ID)
+
+    value.NamesCount = C.uint64_t(len(Names))
+    value.Names = C.CreatestringArray(value.NamesCount)
+
+    for i, v := range Names {
+        C.SetstringArrayElement(value.Names, C.uint64_t(i), C.CString(v))
+    }
+
+    value.Image = C.CString(Image)
+
+    value.LabelsCount = C.uint64_t(len(Labels))
+    value.Labels = C.CreateStringPairArray(value.LabelsCount)
+
+    for i, v := range Labels {
+        C.SetStringPairArrayElement(value.Labels, C.uint64_t(i), v)
+    }
+
+    value.State = C.CString(State)
+    value.Status = C.CString(Status)
+
+    value.PortsCount = C.uint64_t(len(Ports))
+    value.Ports = C.CreatePortBindingArray(value.PortsCount)
+
+    for i, v := range Ports {
+        C.SetPortBindingArrayElement(value.Ports, C.uint64_t(i), v)
+    }
+
+
+    return value
+}
+
+func newListContainersReturn(
+    Containers []ContainerSummary,
+    Error Error,
+) ListContainersReturn {
+    value := C.AllocListContainersReturn()
+
+    value.ContainersCount = C.uint64_t(len(Containers))
+    value.Containers = C.CreateContainerSummaryArray(value.ContainersCount)
+
+    for i, v := range Containers {
+        C.SetContainerSummaryArrayElement(value.Containers, C.uint64_t(i), v)
+    }
+
+    value.Error = Error
+
+    return value
+}
+
+func newStreamContainerLogsRequest(
+    ContainerID string,
+    Follow bool,
+    ShowTimestamps bool,
+    HaveTailLimit bool,
+    TailLines int64,
+    HaveSinceFilter bool,
+    SinceSeconds int64,
+    SinceNanoseconds int64,
+    HaveUntilFilter bool,
+    UntilSeconds int64,
+    UntilNanoseconds int64,
+) StreamContainerLogsRequest {
+    value := C.AllocStreamContainerLogsRequest()
+    value.ContainerID = C.CString(ContainerID)
+    value.Follow = C.bool(Follow)
+    value.ShowTimestamps = C.bool(ShowTimestamps)
+    value.HaveTailLimit = C.bool(HaveTailLimit)
+    value.TailLines = C.int64_t(TailLines)
+    value.HaveSinceFilter = C.bool(HaveSinceFilter)
+    value.SinceSeconds = C.int64_t(SinceSeconds)
+    value.SinceNanoseconds = C.int64_t(SinceNanoseconds)
+    value.HaveUntilFilter = C.bool(HaveUntilFilter)
+    value.UntilSeconds = C.int64_t(UntilSeconds)
+    value.UntilNanoseconds = C.int64_t(UntilNanoseconds)
+
+    return value
+}
+
+func newContainerStatsSample(
+    Timestamp int64,
+    CPUPercentage float64,
+    MemoryUsageBytes int64,
+    MemoryLimitBytes int64,
+    NetworkReceivedBytes int64,
+    NetworkTransmittedBytes int64,
+    NetworkReceivedBytesDelta int64,
+    NetworkTransmittedBytesDelta int64,
+    BlockIOReadBytes int64,
+    BlockIOWrittenBytes int64,
+    BlockIOReadBytesDelta int64,
+    BlockIOWrittenBytesDelta int64,
+) ContainerStatsSample {
+    value := C.AllocContainerStatsSample()
+    value.Timestamp = C.int64_t(Timestamp)
+    value.CPUPercentage = C.double(CPUPercentage)
+    value.MemoryUsageBytes = C.int64_t(MemoryUsageBytes)
+    value.MemoryLimitBytes = C.int64_t(MemoryLimitBytes)
+    value.NetworkReceivedBytes = C.int64_t(NetworkReceivedBytes)
+    value.NetworkTransmittedBytes = C.int64_t(NetworkTransmittedBytes)
+    value.NetworkReceivedBytesDelta = C.int64_t(NetworkReceivedBytesDelta)
+    value.NetworkTransmittedBytesDelta = C.int64_t(NetworkTransmittedBytesDelta)
+    value.BlockIOReadBytes = C.int64_t(BlockIOReadBytes)
+    value.BlockIOWrittenBytes = C.int64_t(BlockIOWrittenBytes)
+    value.BlockIOReadBytesDelta = C.int64_t(BlockIOReadBytesDelta)
+    value.BlockIOWrittenBytesDelta = C.int64_t(BlockIOWrittenBytesDelta)
+
+    return value
+}
+
+func newUploadPath(
+    LocalPath string,
+    ContainerPath string,
+    ExcludePatterns []string,
+    OverrideOwner bool,
+    Owner int32,
+    Group int32,
+    OverrideFileMode bool,
+    FileMode int32,
+    OverrideDirectoryMode bool,
+    DirectoryMode int32,
+) UploadPath {
+    value := C.AllocUploadPath()
+    value.LocalPath = C.CString(LocalPath)
+    value.ContainerPath = C.CString(ContainerPath)
+
+    value.ExcludePatternsCount = C.uint64_t(len(ExcludePatterns))
+    value.ExcludePatterns = C.CreatestringArray(value.ExcludePatternsCount)
+
+    for i, v := range ExcludePatterns {
+        C.SetstringArrayElement(value.ExcludePatterns, C.uint64_t(i), C.CString(v))
+    }
+
+    value.OverrideOwner = C.bool(OverrideOwner)
+    value.Owner = C.int32_t(Owner)
+    value.Group = C.int32_t(Group)
+    value.OverrideFileMode = C.bool(OverrideFileMode)
+    value.FileMode = C.int32_t(FileMode)
+    value.OverrideDirectoryMode = C.bool(OverrideDirectoryMode)
+    value.DirectoryMode = C.int32_t(DirectoryMode)
+
+    return value
+}
+
+func newUploadPathsToContainerRequest(
+    Paths []UploadPath,
+    FollowSymlinks bool,
+) UploadPathsToContainerRequest {
+    value := C.AllocUploadPathsToContainerRequest()
+
+    value.PathsCount = C.uint64_t(len(Paths))
+    value.Paths = C.CreateUploadPathArray(value.PathsCount)
+
+    for i, v := range Paths {
+        C.SetUploadPathArrayElement(value.Paths, C.uint64_t(i), v)
+    }
+
+    value.FollowSymlinks = C.bool(FollowSymlinks)
+
+    return value
+}
+
+func newWaitForContainerToBeHealthyReturn(
+    Outcome string,
+    LastHealthcheckOutput string,
+    ExitCode int64,
+    Error Error,
+) WaitForContainerToBeHealthyReturn {
+    value := C.AllocWaitForContainerToBeHealthyReturn()
+    value.Outcome = C.CString(Outcome)
+    value.LastHealthcheckOutput = C.CString(LastHealthcheckOutput)
+    value.ExitCode = C.int64_t(ExitCode)
+    value.Error = Error
+
+    return value
+}
+
+func newUpdateContainerRequest(
+    MemoryLimitInBytes int64,
+    MemoryReservationInBytes int64,
+    MemorySwapLimitInBytes int64,
+    CPUCount float64,
+    CPUQuota int64,
+    CPUPeriod int64,
+    CPUShares int64,
+    CPUSetCPUs string,
+    CPUSetMemoryNodes string,
+    PidsLimit int64,
+    BlockIOWeight int64,
+    RestartPolicy RestartPolicy,
+) UpdateContainerRequest {
+    value := C.AllocUpdateContainerRequest()
+    value.MemoryLimitInBytes = C.int64_t(MemoryLimitInBytes)
+    value.MemoryReservationInBytes = C.int64_t(MemoryReservationInBytes)
+    value.MemorySwapLimitInBytes = C.int64_t(MemorySwapLimitInBytes)
+    value.CPUCount = C.double(CPUCount)
+    value.CPUQuota = C.int64_t(CPUQuota)
+    value.CPUPeriod = C.int64_t(CPUPeriod)
+    value.CPUShares = C.int64_t(CPUShares)
+    value.CPUSetCPUs = C.CString(CPUSetCPUs)
+    value.CPUSetMemoryNodes = C.CString(CPUSetMemoryNodes)
+    value.PidsLimit = C.int64_t(PidsLimit)
+    value.BlockIOWeight = C.int64_t(BlockIOWeight)
+    value.RestartPolicy = RestartPolicy
+
+    return value
+}
+
+func newCommitContainerRequest(
+    ContainerID string,
+    Reference string,
+    Author string,
+    Message string,
+    Changes []string,
+    Labels []StringPair,
+    Pause bool,
+) CommitContainerRequest {
+    value := C.AllocCommitContainerRequest()
+    value.ContainerID = C.CString(ContainerID)
+    value.Reference = C.CString(Reference)
+    value.Author = C.CString(Author)
+    value.Message = C.CString(Message)
+
+    value.ChangesCount = C.uint64_t(len(Changes))
+    value.Changes = C.CreatestringArray(value.ChangesCount)
+
+    for i, v := range Changes {
+        C.SetstringArrayElement(value.Changes, C.uint64_t(i), C.CString(v))
+    }
+
+
+    value.LabelsCount = C.uint64_t(len(Labels))
+    value.Labels = C.CreateStringPairArray(value.LabelsCount)
+
+    for i, v := range Labels {
+        C.SetStringPairArrayElement(value.Labels, C.uint64_t(i), v)
+    }
+
+    value.Pause = C.bool(Pause)
+
+    return value
+}
+
+func newCommitContainerReturn(
+    Response ImageReference,
+    Error Error,
+) CommitContainerReturn {
+    value := C.AllocCommitContainerReturn()
+    value.Response = Response
+    value.Error = Error
+
+    return value
+}
+
+func newContainerFilesystemChange(
+    Path string,
+    Kind string,
+) ContainerFilesystemChange {
+    value := C.AllocContainerFilesystemChange()
+    value.Path = C.CString(Path)
+    value.Kind = C.CString(Kind)
+
+    return value
+}
+
+func newContainerChangesReturn(
+    Changes []ContainerFilesystemChange,
+    Error Error,
+) ContainerChangesReturn {
+    value := C.AllocContainerChangesReturn()
+
+    value.ChangesCount = C.uint64_t(len(Changes))
+    value.Changes = C.CreateContainerFilesystemChangeArray(value.ChangesCount)
+
+    for i, v := range Changes {
+        C.SetContainerFilesystemChangeArrayElement(value.Changes, C.uint64_t(i), v)
+    }
+
+    value.Error = Error
+
+    return value
+}
+
+func newContainerProcess(
+    Values []string,
+) ContainerProcess {
+    value := C.AllocContainerProcess()
+
+    value.ValuesCount = C.uint64_t(len(Values))
+    value.Values = C.CreatestringArray(value.ValuesCount)
+
+    for i, v := range Values {
+        C.SetstringArrayElement(value.Values, C.uint64_t(i), C.CString(v))
+    }
+
+
+    return value
+}
+
+func newContainerTopResult(
+    Titles []string,
+    Processes []ContainerProcess,
+) ContainerTopResult {
+    value := C.AllocContainerTopResult()
+
+    value.TitlesCount = C.uint64_t(len(Titles))
+    value.Titles = C.CreatestringArray(value.TitlesCount)
+
+    for i, v := range Titles {
+        C.SetstringArrayElement(value.Titles, C.uint64_t(i), C.CString(v))
+    }
+
+
+    value.ProcessesCount = C.uint64_t(len(Processes))
+    value.Processes = C.CreateContainerProcessArray(value.ProcessesCount)
+
+    for i, v := range Processes {
+        C.SetContainerProcessArrayElement(value.Processes, C.uint64_t(i), v)
+    }
+
+
+    return value
+}
+
+func newContainerTopReturn(
+    Response ContainerTopResult,
+    Error Error,
+) ContainerTopReturn {
+    value := C.AllocContainerTopReturn()
+    value.Response = Response
+    value.Error = Error
+
+    return value
+}
+
+func newPruneContainersRequest(
+    Filters []StringToStringListPair,
+) PruneContainersRequest {
+    value := C.AllocPruneContainersRequest()
+
+    value.FiltersCount = C.uint64_t(len(Filters))
+    value.Filters = C.CreateStringToStringListPairArray(value.FiltersCount)
+
+    for i, v := range Filters {
+        C.SetStringToStringListPairArrayElement(value.Filters, C.uint64_t(i), v)
+    }
+
+
+    return value
+}
+
+func newPruneContainersResult(
+    ContainerIDs []string,
+    SpaceReclaimedInBytes int64,
+) PruneContainersResult {
+    value := C.AllocPruneContainersResult()
+
+    value.ContainerIDsCount = C.uint64_t(len(ContainerIDs))
+    value.ContainerIDs = C.CreatestringArray(value.ContainerIDsCount)
+
+    for i, v := range ContainerIDs {
+        C.SetstringArrayElement(value.ContainerIDs, C.uint64_t(i), C.CString(v))
+    }
+
+    value.SpaceReclaimedInBytes = C.int64_t(SpaceReclaimedInBytes)
+
+    return value
+}
+
+func newPruneContainersReturn(
+    Response PruneContainersResult,
+    Error Error,
+) PruneContainersReturn {
+    value := C.AllocPruneContainersReturn()
+    value.Response = Response
+    value.Error = Error
+
+    return value
+}
+
+func newImageConfig(
+    EnvironmentVariables []string,
+    Entrypoint []string,
+    Command []string,
+    WorkingDirectory string,
+    User string,
+    ExposedPorts []string,
+    Volumes []string,
+    Labels []StringPair,
+    Healthcheck ContainerHealthcheckConfig,
+    StopSignal string,
+) ImageConfig {
+    value := C.AllocImageConfig()
+
+    value.EnvironmentVariablesCount = C.uint64_t(len(EnvironmentVariables))
+    value.EnvironmentVariables = C.CreatestringArray(value.EnvironmentVariablesCount)
+
+    for i, v := range EnvironmentVariables {
+        C.SetstringArrayElement(value.EnvironmentVariables, C.uint64_t(i), C.CString(v))
+    }
+
+
+    value.EntrypointCount = C.uint64_t(len(Entrypoint))
+    value.Entrypoint = C.CreatestringArray(value.EntrypointCount)
+
+    for i, v := range Entrypoint {
+        C.SetstringArrayElement(value.Entrypoint, C.uint64_t(i), C.CString(v))
+    }
+
+
+    value.CommandCount = C.uint64_t(len(Command))
+    value.Command = C.CreatestringArray(value.CommandCount)
+
+    for i, v := range Command {
+        C.SetstringArrayElement(value.Command, C.uint64_t(i), C.CString(v))
+    }
+
+    value.WorkingDirectory = C.CString(WorkingDirectory)
+    value.User = C.CString(User)
+
+    value.ExposedPortsCount = C.uint64_t(len(ExposedPorts))
+    value.ExposedPorts = C.CreatestringArray(value.ExposedPortsCount)
+
+    for i, v := range ExposedPorts {
+        C.SetstringArrayElement(value.ExposedPorts, C.uint64_t(i), C.CString(v))
+    }
+
+
+    value.VolumesCount = C.uint64_t(len(Volumes))
+    value.Volumes = C.CreatestringArray(value.VolumesCount)
+
+    for i, v := range Volumes {
+        C.SetstringArrayElement(value.Volumes, C.uint64_t(i), C.CString(v))
+    }
+
+
+    value.LabelsCount = C.uint64_t(len(Labels))
+    value.Labels = C.CreateStringPairArray(value.LabelsCount)
+
+    for i, v := range Labels {
+        C.SetStringPairArrayElement(value.Labels, C.uint64_t(i), v)
+    }
+
+    value.Healthcheck = Healthcheck
+    value.StopSignal = C.CString(StopSignal)
+
+    return value
+}
+
+func newImageInspectionResult(
+    ID string,
+    RepoTags []string,
+    RepoDigests []string,
+    Created int64,
+    SizeInBytes int64,
+    OS string,
+    Architecture string,
+    Variant string,
+    Author string,
+    Config ImageConfig,
+    RootFSType string,
+    RootFSLayers []string,
+) ImageInspectionResult {
+    value := C.AllocImageInspectionResult()
+    value.ID = C.CString(ID)
+
+    value.RepoTagsCount = C.uint64_t(len(RepoTags))
+    value.RepoTags = C.CreatestringArray(value.RepoTagsCount)
+
+    for i, v := range RepoTags {
+        C.SetstringArrayElement(value.RepoTags, C.uint64_t(i), C.CString(v))
+    }
+
+
+    value.RepoDigestsCount = C.uint64_t(len(RepoDigests))
+    value.RepoDigests = C.CreatestringArray(value.RepoDigestsCount)
+
+    for i, v := range RepoDigests {
+        C.SetstringArrayElement(value.RepoDigests, C.uint64_t(i), C.CString(v))
+    }
+
+    value.Created = C.int64_t(Created)
+    value.SizeInBytes = C.int64_t(SizeInBytes)
+    value.OS = C.CString(OS)
+    value.Architecture = C.CString(Architecture)
+    value.Variant = C.CString(Variant)
+    value.Author = C.CString(Author)
+    value.Config = Config
+    value.RootFSType = C.CString(RootFSType)
+
+    value.RootFSLayersCount = C.uint64_t(len(RootFSLayers))
+    value.RootFSLayers = C.CreatestringArray(value.RootFSLayersCount)
+
+    for i, v := range RootFSLayers {
+        C.SetstringArrayElement(value.RootFSLayers, C.uint64_t(i), C.CString(v))
+    }
+
+
+    return value
+}
+
+func newInspectImageReturn(
+    Response ImageInspectionResult,
+    Error Error,
+) InspectImageReturn {
+    value := C.AllocInspectImageReturn()
+    value.Response = Response
+    value.Error = Error
+
+    return value
+}
+
+func newListImagesRequest(
+    All bool,
+    Filters []StringToStringListPair,
+) ListImagesRequest {
+    value := C.AllocListImagesRequest()
+    value.All = C.bool(All)
+
+    value.FiltersCount = C.uint64_t(len(Filters))
+    value.Filters = C.CreateStringToStringListPairArray(value.FiltersCount)
+
+    for i, v := range Filters {
+        C.SetStringToStringListPairArrayElement(value.Filters, C.uint64_t(i), v)
+    }
+
+
+    return value
+}
+
+func newImageSummary(
+    ID string,
+    ParentID string,
+    RepoTags []string,
+    RepoDigests []string,
+    SizeInBytes int64,
+    Created int64,
+    Labels []StringPair,
+) ImageSummary {
+    value := C.AllocImageSummary()
+    value.ID = C.CString(ID)
+    value.ParentID = C.CString(ParentID)
+
+    value.RepoTagsCount = C.uint64_t(len(RepoTags))
+    value.RepoTags = C.CreatestringArray(value.RepoTagsCount)
+
+    for i, v := range RepoTags {
+        C.SetstringArrayElement(value.RepoTags, C.uint64_t(i), C.CString(v))
+    }
+
+
+    value.RepoDigestsCount = C.uint64_t(len(RepoDigests))
+    value.RepoDigests = C.CreatestringArray(value.RepoDigestsCount)
+
+    for i, v := range RepoDigests {
+        C.SetstringArrayElement(value.RepoDigests, C.uint64_t(i), C.CString(v))
+    }
+
+    value.SizeInBytes = C.int64_t(SizeInBytes)
+    value.Created = C.int64_t(Created)
+
+    value.LabelsCount = C.uint64_t(len(Labels))
+    value.Labels = C.CreateStringPairArray(value.LabelsCount)
+
+    for i, v := range Labels {
+        C.SetStringPairArrayElement(value.Labels, C.uint64_t(i), v)
+    }
+
+
+    return value
+}
+
+func newListImagesReturn(
+    Images []ImageSummary,
+    Error Error,
+) ListImagesReturn {
+    value := C.AllocListImagesReturn()
+
+    value.ImagesCount = C.uint64_t(len(Images))
+    value.Images = C.CreateImageSummaryArray(value.ImagesCount)
+
+    for i, v := range Images {
+        C.SetImageSummaryArrayElement(value.Images, C.uint64_t(i), v)
+    }
+
+    value.Error = Error
+
+    return value
+}
+
+func newPushImageReturn(
+    Digest string,
+    Error Error,
+) PushImageReturn {
+    value := C.AllocPushImageReturn()
+    value.Digest = C.CString(Digest)
+    value.Error = Error
+
+    return value
+}
+
+func newSaveImagesRequest(
+    References []string,
+) SaveImagesRequest {
+    value := C.AllocSaveImagesRequest()
+
+    value.ReferencesCount = C.uint64_t(len(References))
+    value.References = C.CreatestringArray(value.ReferencesCount)
+
+    for i, v := range References {
+        C.SetstringArrayElement(value.References, C.uint64_t(i), C.CString(v))
+    }
+
+
+    return value
+}
+
+func newLoadImagesReturn(
+    References []string,
+    Error Error,
+) LoadImagesReturn {
+    value := C.AllocLoadImagesReturn()
+
+    value.ReferencesCount = C.uint64_t(len(References))
+    value.References = C.CreatestringArray(value.ReferencesCount)
+
+    for i, v := range References {
+        C.SetstringArrayElement(value.References, C.uint64_t(i), C.CString(v))
+    }
+
+    value.Error = Error
+
+    return value
+}
+
 func invokePullImageProgressCallback(method PullImageProgressCallback, userData unsafe.Pointer, progress PullImageProgressUpdate) bool {
     return bool(C.InvokePullImageProgressCallback(method, userData, progress))
 }
@@ -1211,3 +2321,7 @@ func invokeEventCallback(method EventCallback, userData unsafe.Pointer, event Ev
     return bool(C.InvokeEventCallback(method, userData, event))
 }
 
+func invokeContainerStatsCallback(method ContainerStatsCallback, userData unsafe.Pointer, stats ContainerStatsSample) bool {
+    return bool(C.InvokeContainerStatsCallback(method, userData, stats))
+}
+
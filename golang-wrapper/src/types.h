@@ -148,6 +148,8 @@ typedef struct {
 
 typedef struct {
     ImageReference* Response;
+    uint64_t AllTagsImagesCount;
+    ImageReference** AllTagsImages;
     Error* Error;
 } PullImageReturn;
 
@@ -274,8 +276,50 @@ typedef struct {
     int64_t LocalPort;
     int64_t ContainerPort;
     char* Protocol;
+    char* LocalIP;
+    int64_t LocalPortRangeEnd;
+    int64_t ContainerPortRangeEnd;
 } ExposedPort;
 
+typedef struct {
+    char* Name;
+    int64_t Soft;
+    int64_t Hard;
+} Ulimit;
+
+typedef struct {
+    char* Type;
+    char* Source;
+    char* Target;
+    bool ReadOnly;
+    char* Consistency;
+    char* BindPropagation;
+    char* VolumeDriver;
+    uint64_t VolumeDriverOptionsCount;
+    StringPair** VolumeDriverOptions;
+    uint64_t VolumeLabelsCount;
+    StringPair** VolumeLabels;
+    bool VolumeNoCopy;
+    char* VolumeSubpath;
+    int64_t TmpfsSizeInBytes;
+    int32_t TmpfsMode;
+} ContainerMount;
+
+typedef struct {
+    char* Name;
+    int64_t MaximumRetryCount;
+} RestartPolicy;
+
+typedef struct {
+    char* NetworkReference;
+    uint64_t AliasesCount;
+    char** Aliases;
+    char* IPv4Address;
+    char* IPv6Address;
+    uint64_t LinksCount;
+    char** Links;
+} ContainerNetworkAttachment;
+
 typedef struct {
     char* ImageReference;
     char* Name;
@@ -323,6 +367,46 @@ typedef struct {
     bool AttachStdin;
     bool StdinOnce;
     bool OpenStdin;
+    int64_t MemoryLimitInBytes;
+    int64_t MemoryReservationInBytes;
+    int64_t MemorySwapLimitInBytes;
+    double CPUCount;
+    int64_t CPUQuota;
+    int64_t CPUPeriod;
+    int64_t CPUShares;
+    char* CPUSetCPUs;
+    char* CPUSetMemoryNodes;
+    int64_t PidsLimit;
+    uint64_t UlimitsCount;
+    Ulimit** Ulimits;
+    int64_t BlockIOWeight;
+    uint64_t MountsCount;
+    ContainerMount** Mounts;
+    bool ReadOnlyRootFilesystem;
+    bool NoNewPrivileges;
+    char* SeccompProfile;
+    char* AppArmorProfile;
+    uint64_t SELinuxLabelsCount;
+    char** SELinuxLabels;
+    uint64_t SysctlsCount;
+    StringPair** Sysctls;
+    char* UsernsMode;
+    uint64_t GroupAddCount;
+    char** GroupAdd;
+    int64_t OOMScoreAdjustment;
+    RestartPolicy* RestartPolicy;
+    char* StopSignal;
+    bool HaveStopTimeout;
+    int64_t StopTimeoutSeconds;
+    uint64_t NetworksCount;
+    ContainerNetworkAttachment** Networks;
+    bool AutoRemove;
+    char* Platform;
+    char* IPCMode;
+    char* PIDMode;
+    char* UTSMode;
+    int64_t InitialConsoleHeight;
+    int64_t InitialConsoleWidth;
 } CreateContainerRequest;
 
 typedef struct {
@@ -350,6 +434,18 @@ typedef struct {
     uint64_t LabelsCount;
     StringPair** Labels;
     ContainerHealthcheckConfig* Healthcheck;
+    char* StopSignal;
+    bool HaveStopTimeout;
+    int64_t StopTimeoutSeconds;
+    char* Image;
+    uint64_t EnvironmentVariablesCount;
+    char** EnvironmentVariables;
+    uint64_t CommandCount;
+    char** Command;
+    uint64_t EntrypointCount;
+    char** Entrypoint;
+    char* WorkingDirectory;
+    char* User;
 } ContainerConfig;
 
 typedef struct {
@@ -367,6 +463,17 @@ typedef struct {
 
 typedef struct {
     ContainerHealthState* Health;
+    char* Status;
+    bool Running;
+    bool Paused;
+    bool Restarting;
+    bool OOMKilled;
+    bool Dead;
+    int64_t PID;
+    int64_t ExitCode;
+    char* Error;
+    int64_t StartedAt;
+    int64_t FinishedAt;
 } ContainerState;
 
 typedef struct {
@@ -377,14 +484,61 @@ typedef struct {
 
 typedef struct {
     ContainerLogConfig* LogConfig;
+    RestartPolicy* RestartPolicy;
 } ContainerHostConfig;
 
+typedef struct {
+    int64_t ContainerPort;
+    char* Protocol;
+    char* HostIP;
+    int64_t HostPort;
+} PortBinding;
+
+typedef struct {
+    char* Type;
+    char* Name;
+    char* Source;
+    char* Destination;
+    char* Driver;
+    char* Mode;
+    bool ReadWrite;
+    char* Propagation;
+} ContainerMountPoint;
+
+typedef struct {
+    char* NetworkName;
+    char* NetworkID;
+    char* EndpointID;
+    uint64_t AliasesCount;
+    char** Aliases;
+    char* IPAddress;
+    int64_t IPPrefixLength;
+    char* Gateway;
+    char* GlobalIPv6Address;
+    int64_t GlobalIPv6PrefixLength;
+    char* IPv6Gateway;
+    char* MacAddress;
+} ContainerNetworkEndpoint;
+
+typedef struct {
+    uint64_t PortsCount;
+    PortBinding** Ports;
+    uint64_t NetworksCount;
+    ContainerNetworkEndpoint** Networks;
+} ContainerNetworkSettings;
+
 typedef struct {
     char* ID;
     char* Name;
     ContainerHostConfig* HostConfig;
     ContainerState* State;
     ContainerConfig* Config;
+    ContainerNetworkSettings* NetworkSettings;
+    int64_t Created;
+    char* ImageID;
+    int64_t RestartCount;
+    uint64_t MountsCount;
+    ContainerMountPoint** Mounts;
 } ContainerInspectionResult;
 
 typedef struct {
@@ -461,6 +615,8 @@ typedef struct {
     char* WorkingDirectory;
     char* User;
     bool Privileged;
+    int64_t InitialConsoleHeight;
+    int64_t InitialConsoleWidth;
 } CreateExecRequest;
 
 typedef struct {
@@ -482,6 +638,248 @@ typedef struct {
     Error* Error;
 } InspectExecReturn;
 
+typedef struct {
+    bool All;
+    uint64_t FiltersCount;
+    StringToStringListPair** Filters;
+} ListContainersRequest;
+
+typedef struct {
+    char* ID;
+    uint64_t NamesCount;
+    char** Names;
+    char* Image;
+    uint64_t LabelsCount;
+    StringPair** Labels;
+    char* State;
+    char* Status;
+    uint64_t PortsCount;
+    PortBinding** Ports;
+} ContainerSummary;
+
+typedef struct {
+    uint64_t ContainersCount;
+    ContainerSummary** Containers;
+    Error* Error;
+} ListContainersReturn;
+
+typedef struct {
+    char* ContainerID;
+    bool Follow;
+    bool ShowTimestamps;
+    bool HaveTailLimit;
+    int64_t TailLines;
+    bool HaveSinceFilter;
+    int64_t SinceSeconds;
+    int64_t SinceNanoseconds;
+    bool HaveUntilFilter;
+    int64_t UntilSeconds;
+    int64_t UntilNanoseconds;
+} StreamContainerLogsRequest;
+
+typedef struct {
+    int64_t Timestamp;
+    double CPUPercentage;
+    int64_t MemoryUsageBytes;
+    int64_t MemoryLimitBytes;
+    int64_t NetworkReceivedBytes;
+    int64_t NetworkTransmittedBytes;
+    int64_t NetworkReceivedBytesDelta;
+    int64_t NetworkTransmittedBytesDelta;
+    int64_t BlockIOReadBytes;
+    int64_t BlockIOWrittenBytes;
+    int64_t BlockIOReadBytesDelta;
+    int64_t BlockIOWrittenBytesDelta;
+} ContainerStatsSample;
+
+typedef bool (*ContainerStatsCallback) (void*, ContainerStatsSample*);
+
+typedef struct {
+    char* LocalPath;
+    char* ContainerPath;
+    uint64_t ExcludePatternsCount;
+    char** ExcludePatterns;
+    bool OverrideOwner;
+    int32_t Owner;
+    int32_t Group;
+    bool OverrideFileMode;
+    int32_t FileMode;
+    bool OverrideDirectoryMode;
+    int32_t DirectoryMode;
+} UploadPath;
+
+typedef struct {
+    uint64_t PathsCount;
+    UploadPath** Paths;
+    bool FollowSymlinks;
+} UploadPathsToContainerRequest;
+
+typedef struct {
+    char* Outcome;
+    char* LastHealthcheckOutput;
+    int64_t ExitCode;
+    Error* Error;
+} WaitForContainerToBeHealthyReturn;
+
+typedef struct {
+    int64_t MemoryLimitInBytes;
+    int64_t MemoryReservationInBytes;
+    int64_t MemorySwapLimitInBytes;
+    double CPUCount;
+    int64_t CPUQuota;
+    int64_t CPUPeriod;
+    int64_t CPUShares;
+    char* CPUSetCPUs;
+    char* CPUSetMemoryNodes;
+    int64_t PidsLimit;
+    int64_t BlockIOWeight;
+    RestartPolicy* RestartPolicy;
+} UpdateContainerRequest;
+
+typedef struct {
+    char* ContainerID;
+    char* Reference;
+    char* Author;
+    char* Message;
+    uint64_t ChangesCount;
+    char** Changes;
+    uint64_t LabelsCount;
+    StringPair** Labels;
+    bool Pause;
+} CommitContainerRequest;
+
+typedef struct {
+    ImageReference* Response;
+    Error* Error;
+} CommitContainerReturn;
+
+typedef struct {
+    char* Path;
+    char* Kind;
+} ContainerFilesystemChange;
+
+typedef struct {
+    uint64_t ChangesCount;
+    ContainerFilesystemChange** Changes;
+    Error* Error;
+} ContainerChangesReturn;
+
+typedef struct {
+    uint64_t ValuesCount;
+    char** Values;
+} ContainerProcess;
+
+typedef struct {
+    uint64_t TitlesCount;
+    char** Titles;
+    uint64_t ProcessesCount;
+    ContainerProcess** Processes;
+} ContainerTopResult;
+
+typedef struct {
+    ContainerTopResult* Response;
+    Error* Error;
+} ContainerTopReturn;
+
+typedef struct {
+    uint64_t FiltersCount;
+    StringToStringListPair** Filters;
+} PruneContainersRequest;
+
+typedef struct {
+    uint64_t ContainerIDsCount;
+    char** ContainerIDs;
+    int64_t SpaceReclaimedInBytes;
+} PruneContainersResult;
+
+typedef struct {
+    PruneContainersResult* Response;
+    Error* Error;
+} PruneContainersReturn;
+
+typedef struct {
+    uint64_t EnvironmentVariablesCount;
+    char** EnvironmentVariables;
+    uint64_t EntrypointCount;
+    char** Entrypoint;
+    uint64_t CommandCount;
+    char** Command;
+    char* WorkingDirectory;
+    char* User;
+    uint64_t ExposedPortsCount;
+    char** ExposedPorts;
+    uint64_t VolumesCount;
+    char** Volumes;
+    uint64_t LabelsCount;
+    StringPair** Labels;
+    ContainerHealthcheckConfig* Healthcheck;
+    char* StopSignal;
+} ImageConfig;
+
+typedef struct {
+    char* ID;
+    uint64_t RepoTagsCount;
+    char** RepoTags;
+    uint64_t RepoDigestsCount;
+    char** RepoDigests;
+    int64_t Created;
+    int64_t SizeInBytes;
+    char* OS;
+    char* Architecture;
+    char* Variant;
+    char* Author;
+    ImageConfig* Config;
+    char* RootFSType;
+    uint64_t RootFSLayersCount;
+    char** RootFSLayers;
+} ImageInspectionResult;
+
+typedef struct {
+    ImageInspectionResult* Response;
+    Error* Error;
+} InspectImageReturn;
+
+typedef struct {
+    bool All;
+    uint64_t FiltersCount;
+    StringToStringListPair** Filters;
+} ListImagesRequest;
+
+typedef struct {
+    char* ID;
+    char* ParentID;
+    uint64_t RepoTagsCount;
+    char** RepoTags;
+    uint64_t RepoDigestsCount;
+    char** RepoDigests;
+    int64_t SizeInBytes;
+    int64_t Created;
+    uint64_t LabelsCount;
+    StringPair** Labels;
+} ImageSummary;
+
+typedef struct {
+    uint64_t ImagesCount;
+    ImageSummary** Images;
+    Error* Error;
+} ListImagesReturn;
+
+typedef struct {
+    char* Digest;
+    Error* Error;
+} PushImageReturn;
+
+typedef struct {
+    uint64_t ReferencesCount;
+    char** References;
+} SaveImagesRequest;
+
+typedef struct {
+    uint64_t ReferencesCount;
+    char** References;
+    Error* Error;
+} LoadImagesReturn;
+
 EXPORTED_FUNCTION Error* AllocError();
 EXPORTED_FUNCTION void FreeError(Error* value);
 EXPORTED_FUNCTION TLSConfiguration* AllocTLSConfiguration();
@@ -564,6 +962,14 @@ EXPORTED_FUNCTION DeviceMount* AllocDeviceMount();
 EXPORTED_FUNCTION void FreeDeviceMount(DeviceMount* value);
 EXPORTED_FUNCTION ExposedPort* AllocExposedPort();
 EXPORTED_FUNCTION void FreeExposedPort(ExposedPort* value);
+EXPORTED_FUNCTION Ulimit* AllocUlimit();
+EXPORTED_FUNCTION void FreeUlimit(Ulimit* value);
+EXPORTED_FUNCTION ContainerMount* AllocContainerMount();
+EXPORTED_FUNCTION void FreeContainerMount(ContainerMount* value);
+EXPORTED_FUNCTION RestartPolicy* AllocRestartPolicy();
+EXPORTED_FUNCTION void FreeRestartPolicy(RestartPolicy* value);
+EXPORTED_FUNCTION ContainerNetworkAttachment* AllocContainerNetworkAttachment();
+EXPORTED_FUNCTION void FreeContainerNetworkAttachment(ContainerNetworkAttachment* value);
 EXPORTED_FUNCTION CreateContainerRequest* AllocCreateContainerRequest();
 EXPORTED_FUNCTION void FreeCreateContainerRequest(CreateContainerRequest* value);
 EXPORTED_FUNCTION CreateContainerReturn* AllocCreateContainerReturn();
@@ -585,6 +991,14 @@ EXPORTED_FUNCTION ContainerLogConfig* AllocContainerLogConfig();
 EXPORTED_FUNCTION void FreeContainerLogConfig(ContainerLogConfig* value);
 EXPORTED_FUNCTION ContainerHostConfig* AllocContainerHostConfig();
 EXPORTED_FUNCTION void FreeContainerHostConfig(ContainerHostConfig* value);
+EXPORTED_FUNCTION PortBinding* AllocPortBinding();
+EXPORTED_FUNCTION void FreePortBinding(PortBinding* value);
+EXPORTED_FUNCTION ContainerMountPoint* AllocContainerMountPoint();
+EXPORTED_FUNCTION void FreeContainerMountPoint(ContainerMountPoint* value);
+EXPORTED_FUNCTION ContainerNetworkEndpoint* AllocContainerNetworkEndpoint();
+EXPORTED_FUNCTION void FreeContainerNetworkEndpoint(ContainerNetworkEndpoint* value);
+EXPORTED_FUNCTION ContainerNetworkSettings* AllocContainerNetworkSettings();
+EXPORTED_FUNCTION void FreeContainerNetworkSettings(ContainerNetworkSettings* value);
 EXPORTED_FUNCTION ContainerInspectionResult* AllocContainerInspectionResult();
 EXPORTED_FUNCTION void FreeContainerInspectionResult(ContainerInspectionResult* value);
 EXPORTED_FUNCTION InspectContainerReturn* AllocInspectContainerReturn();
@@ -614,9 +1028,69 @@ EXPORTED_FUNCTION InspectExecResult* AllocInspectExecResult();
 EXPORTED_FUNCTION void FreeInspectExecResult(InspectExecResult* value);
 EXPORTED_FUNCTION InspectExecReturn* AllocInspectExecReturn();
 EXPORTED_FUNCTION void FreeInspectExecReturn(InspectExecReturn* value);
+EXPORTED_FUNCTION ListContainersRequest* AllocListContainersRequest();
+EXPORTED_FUNCTION void FreeListContainersRequest(ListContainersRequest* value);
+EXPORTED_FUNCTION ContainerSummary* AllocContainerSummary();
+EXPORTED_FUNCTION void FreeContainerSummary(ContainerSummary* value);
+EXPORTED_FUNCTION ListContainersReturn* AllocListContainersReturn();
+EXPORTED_FUNCTION void FreeListContainersReturn(ListContainersReturn* value);
+EXPORTED_FUNCTION StreamContainerLogsRequest* AllocStreamContainerLogsRequest();
+EXPORTED_FUNCTION void FreeStreamContainerLogsRequest(StreamContainerLogsRequest* value);
+EXPORTED_FUNCTION ContainerStatsSample* AllocContainerStatsSample();
+EXPORTED_FUNCTION void FreeContainerStatsSample(ContainerStatsSample* value);
+EXPORTED_FUNCTION bool InvokeContainerStatsCallback(ContainerStatsCallback method, void* userData, ContainerStatsSample* stats);
+EXPORTED_FUNCTION UploadPath* AllocUploadPath();
+EXPORTED_FUNCTION void FreeUploadPath(UploadPath* value);
+EXPORTED_FUNCTION UploadPathsToContainerRequest* AllocUploadPathsToContainerRequest();
+EXPORTED_FUNCTION void FreeUploadPathsToContainerRequest(UploadPathsToContainerRequest* value);
+EXPORTED_FUNCTION WaitForContainerToBeHealthyReturn* AllocWaitForContainerToBeHealthyReturn();
+EXPORTED_FUNCTION void FreeWaitForContainerToBeHealthyReturn(WaitForContainerToBeHealthyReturn* value);
+EXPORTED_FUNCTION UpdateContainerRequest* AllocUpdateContainerRequest();
+EXPORTED_FUNCTION void FreeUpdateContainerRequest(UpdateContainerRequest* value);
+EXPORTED_FUNCTION CommitContainerRequest* AllocCommitContainerRequest();
+EXPORTED_FUNCTION void FreeCommitContainerRequest(CommitContainerRequest* value);
+EXPORTED_FUNCTION CommitContainerReturn* AllocCommitContainerReturn();
+EXPORTED_FUNCTION void FreeCommitContainerReturn(CommitContainerReturn* value);
+EXPORTED_FUNCTION ContainerFilesystemChange* AllocContainerFilesystemChange();
+EXPORTED_FUNCTION void FreeContainerFilesystemChange(ContainerFilesystemChange* value);
+EXPORTED_FUNCTION ContainerChangesReturn* AllocContainerChangesReturn();
+EXPORTED_FUNCTION void FreeContainerChangesReturn(ContainerChangesReturn* value);
+EXPORTED_FUNCTION ContainerProcess* AllocContainerProcess();
+EXPORTED_FUNCTION void FreeContainerProcess(ContainerProcess* value);
+EXPORTED_FUNCTION ContainerTopResult* AllocContainerTopResult();
+EXPORTED_FUNCTION void FreeContainerTopResult(ContainerTopResult* value);
+EXPORTED_FUNCTION ContainerTopReturn* AllocContainerTopReturn();
+EXPORTED_FUNCTION void FreeContainerTopReturn(ContainerTopReturn* value);
+EXPORTED_FUNCTION PruneContainersRequest* AllocPruneContainersRequest();
+EXPORTED_FUNCTION void FreePruneContainersRequest(PruneContainersRequest* value);
+EXPORTED_FUNCTION PruneContainersResult* AllocPruneContainersResult();
+EXPORTED_FUNCTION void FreePruneContainersResult(PruneContainersResult* value);
+EXPORTED_FUNCTION PruneContainersReturn* AllocPruneContainersReturn();
+EXPORTED_FUNCTION void FreePruneContainersReturn(PruneContainersReturn* value);
+EXPORTED_FUNCTION ImageConfig* AllocImageConfig();
+EXPORTED_FUNCTION void FreeImageConfig(ImageConfig* value);
+EXPORTED_FUNCTION ImageInspectionResult* AllocImageInspectionResult();
+EXPORTED_FUNCTION void FreeImageInspectionResult(ImageInspectionResult* value);
+EXPORTED_FUNCTION InspectImageReturn* AllocInspectImageReturn();
+EXPORTED_FUNCTION void FreeInspectImageReturn(InspectImageReturn* value);
+EXPORTED_FUNCTION ListImagesRequest* AllocListImagesRequest();
+EXPORTED_FUNCTION void FreeListImagesRequest(ListImagesRequest* value);
+EXPORTED_FUNCTION ImageSummary* AllocImageSummary();
+EXPORTED_FUNCTION void FreeImageSummary(ImageSummary* value);
+EXPORTED_FUNCTION ListImagesReturn* AllocListImagesReturn();
+EXPORTED_FUNCTION void FreeListImagesReturn(ListImagesReturn* value);
+EXPORTED_FUNCTION PushImageReturn* AllocPushImageReturn();
+EXPORTED_FUNCTION void FreePushImageReturn(PushImageReturn* value);
+EXPORTED_FUNCTION SaveImagesRequest* AllocSaveImagesRequest();
+EXPORTED_FUNCTION void FreeSaveImagesRequest(SaveImagesRequest* value);
+EXPORTED_FUNCTION LoadImagesReturn* AllocLoadImagesReturn();
+EXPORTED_FUNCTION void FreeLoadImagesReturn(LoadImagesReturn* value);
 EXPORTED_FUNCTION VolumeReference** CreateVolumeReferenceArray(uint64_t size);
 EXPORTED_FUNCTION void SetVolumeReferenceArrayElement(VolumeReference** array, uint64_t index, VolumeReference* value);
 EXPORTED_FUNCTION VolumeReference* GetVolumeReferenceArrayElement(VolumeReference** array, uint64_t index);
+EXPORTED_FUNCTION ImageReference** CreateImageReferenceArray(uint64_t size);
+EXPORTED_FUNCTION void SetImageReferenceArrayElement(ImageReference** array, uint64_t index, ImageReference* value);
+EXPORTED_FUNCTION ImageReference* GetImageReferenceArrayElement(ImageReference** array, uint64_t index);
 EXPORTED_FUNCTION char** CreatestringArray(uint64_t size);
 EXPORTED_FUNCTION void SetstringArrayElement(char** array, uint64_t index, char* value);
 EXPORTED_FUNCTION char* GetstringArrayElement(char** array, uint64_t index);
@@ -638,9 +1112,27 @@ EXPORTED_FUNCTION DeviceMount* GetDeviceMountArrayElement(DeviceMount** array, u
 EXPORTED_FUNCTION ExposedPort** CreateExposedPortArray(uint64_t size);
 EXPORTED_FUNCTION void SetExposedPortArrayElement(ExposedPort** array, uint64_t index, ExposedPort* value);
 EXPORTED_FUNCTION ExposedPort* GetExposedPortArrayElement(ExposedPort** array, uint64_t index);
+EXPORTED_FUNCTION Ulimit** CreateUlimitArray(uint64_t size);
+EXPORTED_FUNCTION void SetUlimitArrayElement(Ulimit** array, uint64_t index, Ulimit* value);
+EXPORTED_FUNCTION Ulimit* GetUlimitArrayElement(Ulimit** array, uint64_t index);
+EXPORTED_FUNCTION ContainerMount** CreateContainerMountArray(uint64_t size);
+EXPORTED_FUNCTION void SetContainerMountArrayElement(ContainerMount** array, uint64_t index, ContainerMount* value);
+EXPORTED_FUNCTION ContainerMount* GetContainerMountArrayElement(ContainerMount** array, uint64_t index);
+EXPORTED_FUNCTION ContainerNetworkAttachment** CreateContainerNetworkAttachmentArray(uint64_t size);
+EXPORTED_FUNCTION void SetContainerNetworkAttachmentArrayElement(ContainerNetworkAttachment** array, uint64_t index, ContainerNetworkAttachment* value);
+EXPORTED_FUNCTION ContainerNetworkAttachment* GetContainerNetworkAttachmentArrayElement(ContainerNetworkAttachment** array, uint64_t index);
 EXPORTED_FUNCTION ContainerHealthLogEntry** CreateContainerHealthLogEntryArray(uint64_t size);
 EXPORTED_FUNCTION void SetContainerHealthLogEntryArrayElement(ContainerHealthLogEntry** array, uint64_t index, ContainerHealthLogEntry* value);
 EXPORTED_FUNCTION ContainerHealthLogEntry* GetContainerHealthLogEntryArrayElement(ContainerHealthLogEntry** array, uint64_t index);
+EXPORTED_FUNCTION PortBinding** CreatePortBindingArray(uint64_t size);
+EXPORTED_FUNCTION void SetPortBindingArrayElement(PortBinding** array, uint64_t index, PortBinding* value);
+EXPORTED_FUNCTION PortBinding* GetPortBindingArrayElement(PortBinding** array, uint64_t index);
+EXPORTED_FUNCTION ContainerNetworkEndpoint** CreateContainerNetworkEndpointArray(uint64_t size);
+EXPORTED_FUNCTION void SetContainerNetworkEndpointArrayElement(ContainerNetworkEndpoint** array, uint64_t index, ContainerNetworkEndpoint* value);
+EXPORTED_FUNCTION ContainerNetworkEndpoint* GetContainerNetworkEndpointArrayElement(ContainerNetworkEndpoint** array, uint64_t index);
+EXPORTED_FUNCTION ContainerMountPoint** CreateContainerMountPointArray(uint64_t size);
+EXPORTED_FUNCTION void SetContainerMountPointArrayElement(ContainerMountPoint** array, uint64_t index, ContainerMountPoint* value);
+EXPORTED_FUNCTION ContainerMountPoint* GetContainerMountPointArrayElement(ContainerMountPoint** array, uint64_t index);
 EXPORTED_FUNCTION UploadDirectory** CreateUploadDirectoryArray(uint64_t size);
 EXPORTED_FUNCTION void SetUploadDirectoryArrayElement(UploadDirectory** array, uint64_t index, UploadDirectory* value);
 EXPORTED_FUNCTION UploadDirectory* GetUploadDirectoryArrayElement(UploadDirectory** array, uint64_t index);
@@ -650,4 +1142,19 @@ EXPORTED_FUNCTION UploadFile* GetUploadFileArrayElement(UploadFile** array, uint
 EXPORTED_FUNCTION StringToStringListPair** CreateStringToStringListPairArray(uint64_t size);
 EXPORTED_FUNCTION void SetStringToStringListPairArrayElement(StringToStringListPair** array, uint64_t index, StringToStringListPair* value);
 EXPORTED_FUNCTION StringToStringListPair* GetStringToStringListPairArrayElement(StringToStringListPair** array, uint64_t index);
+EXPORTED_FUNCTION ContainerSummary** CreateContainerSummaryArray(uint64_t size);
+EXPORTED_FUNCTION void SetContainerSummaryArrayElement(ContainerSummary** array, uint64_t index, ContainerSummary* value);
+EXPORTED_FUNCTION ContainerSummary* GetContainerSummaryArrayElement(ContainerSummary** array, uint64_t index);
+EXPORTED_FUNCTION UploadPath** CreateUploadPathArray(uint64_t size);
+EXPORTED_FUNCTION void SetUploadPathArrayElement(UploadPath** array, uint64_t index, UploadPath* value);
+EXPORTED_FUNCTION UploadPath* GetUploadPathArrayElement(UploadPath** array, uint64_t index);
+EXPORTED_FUNCTION ContainerFilesystemChange** CreateContainerFilesystemChangeArray(uint64_t size);
+EXPORTED_FUNCTION void SetContainerFilesystemChangeArrayElement(ContainerFilesystemChange** array, uint64_t index, ContainerFilesystemChange* value);
+EXPORTED_FUNCTION ContainerFilesystemChange* GetContainerFilesystemChangeArrayElement(ContainerFilesystemChange** array, uint64_t index);
+EXPORTED_FUNCTION ContainerProcess** CreateContainerProcessArray(uint64_t size);
+EXPORTED_FUNCTION void SetContainerProcessArrayElement(ContainerProcess** array, uint64_t index, ContainerProcess* value);
+EXPORTED_FUNCTION ContainerProcess* GetContainerProcessArrayElement(ContainerProcess** array, uint64_t index);
+EXPORTED_FUNCTION ImageSummary** CreateImageSummaryArray(uint64_t size);
+EXPORTED_FUNCTION void SetImageSummaryArrayElement(ImageSummary** array, uint64_t index, ImageSummary* value);
+EXPORTED_FUNCTION ImageSummary* GetImageSummaryArrayElement(ImageSummary** array, uint64_t index);
 #endif